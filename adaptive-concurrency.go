@@ -0,0 +1,150 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// AdaptiveConcurrency limits the requests in flight per bucket. The
+// limit is halved whenever the server throttles requests with a 503
+// or 429 response, e.g. SlowDown, and grows by one for every limit
+// worth of successful requests, up to MaxRequests. Requests wait for
+// a free slot, so parallel uploads and batch operations slow down as
+// a whole instead of failing together.
+type AdaptiveConcurrency struct {
+	// MaxRequests in flight per bucket, defaults to 256.
+	MaxRequests int
+
+	// MinRequests in flight per bucket, defaults to 1.
+	MinRequests int
+}
+
+// aimdLimiter - limits concurrent requests with additive increase and
+// multiplicative decrease of the limit.
+type aimdLimiter struct {
+	min, max float64
+
+	mu       sync.Mutex
+	limit    float64
+	inflight int
+	// Throttled requests started before the last decrease do not
+	// decrease the limit again.
+	lastDecrease time.Time
+	// Closed and replaced whenever a slot is released.
+	released chan struct{}
+}
+
+// acquire - waits for a free slot, returns the start time of the
+// request to be passed to release.
+func (l *aimdLimiter) acquire(ctx context.Context) (time.Time, error) {
+	if l == nil {
+		return time.Time{}, nil
+	}
+	for {
+		l.mu.Lock()
+		if l.inflight < int(l.limit) {
+			l.inflight++
+			l.mu.Unlock()
+			return time.Now(), nil
+		}
+		released := l.released
+		l.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return time.Time{}, ctx.Err()
+		case <-released:
+		}
+	}
+}
+
+// release - frees the slot of a request started at start, adapting the
+// limit to whether it was throttled.
+func (l *aimdLimiter) release(start time.Time, throttled bool) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	l.inflight--
+	switch {
+	case !throttled:
+		l.limit = min(l.limit+1/l.limit, l.max)
+	case start.After(l.lastDecrease):
+		l.limit = max(l.limit/2, l.min)
+		l.lastDecrease = time.Now()
+	}
+	close(l.released)
+	l.released = make(chan struct{})
+	l.mu.Unlock()
+}
+
+// concurrencyLimiters - the limiters of all buckets of a client, all
+// methods are no-ops on a nil concurrencyLimiters.
+type concurrencyLimiters struct {
+	min, max float64
+
+	mu       sync.Mutex
+	limiters map[string]*aimdLimiter
+}
+
+// newConcurrencyLimiters - returns the limiters of cfg, nil if cfg is
+// nil.
+func newConcurrencyLimiters(cfg *AdaptiveConcurrency) *concurrencyLimiters {
+	if cfg == nil {
+		return nil
+	}
+	l := &concurrencyLimiters{
+		min:      float64(max(cfg.MinRequests, 1)),
+		max:      float64(cfg.MaxRequests),
+		limiters: make(map[string]*aimdLimiter),
+	}
+	if l.max <= 0 {
+		l.max = 256
+	}
+	l.max = max(l.max, l.min)
+	return l
+}
+
+// limiter - returns the limiter of bucketName.
+func (l *concurrencyLimiters) limiter(bucketName string) *aimdLimiter {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	limiter, ok := l.limiters[bucketName]
+	if !ok {
+		limiter = &aimdLimiter{
+			min:      l.min,
+			max:      l.max,
+			limit:    l.max,
+			released: make(chan struct{}),
+		}
+		l.limiters[bucketName] = limiter
+	}
+	return limiter
+}
+
+// isThrottled - returns true if res asks the client to slow down.
+func isThrottled(res *http.Response) bool {
+	return res != nil && (res.StatusCode == http.StatusServiceUnavailable || res.StatusCode == http.StatusTooManyRequests)
+}
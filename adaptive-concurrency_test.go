@@ -0,0 +1,117 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestAIMDLimiter(t *testing.T) {
+	l := newConcurrencyLimiters(&AdaptiveConcurrency{MaxRequests: 8, MinRequests: 2}).limiter("bucket")
+	ctx := context.Background()
+
+	early, _ := l.acquire(ctx)
+	late, _ := l.acquire(ctx)
+	l.release(late, true)
+	if l.limit != 4 {
+		t.Fatalf("Expected the limit to be halved to 4, got %v", l.limit)
+	}
+	// Requests sent before the decrease do not decrease it again.
+	l.release(early, true)
+	if l.limit != 4 {
+		t.Fatalf("Expected the limit to stay 4, got %v", l.limit)
+	}
+
+	for range 2 {
+		sent, _ := l.acquire(ctx)
+		l.release(sent, true)
+	}
+	if l.limit != 2 {
+		t.Fatalf("Expected the limit to stop at 2, got %v", l.limit)
+	}
+
+	// About a limit worth of successes grows the limit by one.
+	for range 3 {
+		sent, _ := l.acquire(ctx)
+		l.release(sent, false)
+	}
+	if l.limit < 3 || l.limit >= 4 {
+		t.Fatalf("Expected the limit to grow to 3, got %v", l.limit)
+	}
+
+	// Requests wait for a free slot.
+	a, _ := l.acquire(ctx)
+	l.acquire(ctx)
+	l.acquire(ctx)
+	cctx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	if _, err := l.acquire(cctx); err != context.DeadlineExceeded {
+		t.Fatalf("Expected %v, got %v", context.DeadlineExceeded, err)
+	}
+	l.release(a, false)
+	if _, err := l.acquire(ctx); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAdaptiveConcurrency(t *testing.T) {
+	var inflight, maxInflight atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inflight.Add(1)
+		defer inflight.Add(-1)
+		for {
+			m := maxInflight.Load()
+			if n <= m || maxInflight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		w.Header().Set("ETag", "\"etag\"")
+		w.Header().Set("Last-Modified", time.Now().UTC().Format(http.TimeFormat))
+	}))
+	defer srv.Close()
+
+	clnt, err := New(srv.Listener.Addr().String(), &Options{
+		Region:              "us-east-1",
+		AdaptiveConcurrency: &AdaptiveConcurrency{MaxRequests: 3},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := clnt.StatObject(context.Background(), "bucket", "object", StatObjectOptions{}); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	if n := maxInflight.Load(); n > 3 {
+		t.Fatalf("Expected at most 3 requests in flight, got %d", n)
+	}
+}
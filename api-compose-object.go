@@ -80,6 +80,11 @@ type CopyDestOptions struct {
 	Size int64 // Needs to be specified if progress bar is specified.
 	// Progress of the entire copy operation will be sent here.
 	Progress io.Reader
+
+	// ProgressListener is notified about the progress of ComposeObject,
+	// every upload-part-copy request is reported as a part once the
+	// server copied it.
+	ProgressListener ProgressListener
 }
 
 // Process custom-metadata to remove a `x-amz-meta-` prefix if
@@ -406,7 +411,7 @@ func (c *Client) uploadPartCopy(ctx context.Context, bucket, object, uploadID st
 // and concatenates them into a new object using only server-side copying
 // operations. Optionally takes progress reader hook for applications to
 // look at current progress.
-func (c *Client) ComposeObject(ctx context.Context, dst CopyDestOptions, srcs ...CopySrcOptions) (UploadInfo, error) {
+func (c *Client) ComposeObject(ctx context.Context, dst CopyDestOptions, srcs ...CopySrcOptions) (info UploadInfo, err error) {
 	if len(srcs) < 1 || len(srcs) > maxPartsCount {
 		return UploadInfo{}, errInvalidArgument("There must be as least one and up to 10000 source objects.")
 	}
@@ -424,7 +429,6 @@ func (c *Client) ComposeObject(ctx context.Context, dst CopyDestOptions, srcs ..
 	srcObjectInfos := make([]ObjectInfo, len(srcs))
 	srcObjectSizes := make([]int64, len(srcs))
 	var totalSize, totalParts int64
-	var err error
 	for i, src := range srcs {
 		opts := StatObjectOptions{ServerSideEncryption: encrypt.SSE(src.Encryption), VersionID: src.VersionID}
 		srcObjectInfos[i], err = c.StatObject(context.Background(), src.Bucket, src.Object, opts)
@@ -471,11 +475,21 @@ func (c *Client) ComposeObject(ctx context.Context, dst CopyDestOptions, srcs ..
 		}
 	}
 
+	progress := newTransferProgress(dst.ProgressListener, dst.Bucket, dst.Object)
+	progress.started(totalSize)
+	defer func() {
+		progress.completed(err)
+	}()
+
 	// Single source object case (i.e. when only one source is
 	// involved, it is being copied wholly and at most 5GiB in
 	// size, emptyfiles are also supported).
 	if (totalParts == 1 && srcs[0].Start == -1 && totalSize <= maxPartSize) || (totalSize == 0) {
-		return c.CopyObject(ctx, dst, srcs[0])
+		info, err = c.CopyObject(ctx, dst, srcs[0])
+		if err == nil {
+			progress.transferred(0, totalSize)
+		}
+		return info, err
 	}
 
 	// Now, handle multipart-copy cases.
@@ -512,6 +526,12 @@ func (c *Client) ComposeObject(ctx context.Context, dst CopyDestOptions, srcs ..
 		Mode:                 dst.Mode,
 		RetainUntilDate:      dst.RetainUntilDate,
 		LegalHold:            dst.LegalHold,
+		ContentType:          dst.ContentType,
+		ContentEncoding:      dst.ContentEncoding,
+		ContentDisposition:   dst.ContentDisposition,
+		ContentLanguage:      dst.ContentLanguage,
+		CacheControl:         dst.CacheControl,
+		Expires:              dst.Expires,
 	})
 	if err != nil {
 		return UploadInfo{}, err
@@ -539,11 +559,16 @@ func (c *Client) ComposeObject(ctx context.Context, dst CopyDestOptions, srcs ..
 				fmt.Sprintf("bytes=%d-%d", start, end))
 
 			// make upload-part-copy request
+			part := progress.part(partIndex, end-start+1)
+			part.started()
 			complPart, err := c.uploadPartCopy(ctx, dst.Bucket,
 				dst.Object, uploadID, partIndex, h)
 			if err != nil {
+				part.done(err)
 				return UploadInfo{}, err
 			}
+			part.transferred(end - start + 1)
+			part.done(nil)
 			if dst.Progress != nil {
 				io.CopyN(io.Discard, dst.Progress, end-start+1)
 			}
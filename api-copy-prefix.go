@@ -0,0 +1,215 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"iter"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/s3utils"
+)
+
+// CopyPrefixOptions are used to configure CopyPrefix and MovePrefix.
+type CopyPrefixOptions struct {
+	// NumThreads is the number of objects copied concurrently,
+	// defaults to 4.
+	NumThreads int
+
+	// PreserveRetention copies the object lock retention mode and
+	// retain until date of every source object.
+	PreserveRetention bool
+
+	// PreserveLegalHold copies the legal hold status of every
+	// source object.
+	PreserveLegalHold bool
+}
+
+// getNumThreads - gets the number of objects copied concurrently.
+func (opts CopyPrefixOptions) getNumThreads() int {
+	if opts.NumThreads > 0 {
+		return opts.NumThreads
+	}
+	return totalWorkers
+}
+
+// CopyPrefixResult is the outcome of copying a single object.
+type CopyPrefixResult struct {
+	SourceObject string
+	DestObject   string
+	Size         int64
+	Info         UploadInfo
+
+	// Removed is set by MovePrefix once the source object is removed.
+	Removed bool
+
+	Err error
+}
+
+// CopyPrefix copies every object below srcPrefix in srcBucket to
+// dstPrefix in dstBucket using server side copy. Objects up to 5GiB
+// are copied with CopyObject, larger objects with a multipart
+// ComposeObject. User metadata, tags and content headers are kept,
+// retention and legal hold are kept if requested in opts.
+//
+// A result is returned for every object as soon as it is copied.
+// Stopping the iteration or canceling the context stops the copy.
+func (c *Client) CopyPrefix(ctx context.Context, srcBucket, srcPrefix, dstBucket, dstPrefix string, opts CopyPrefixOptions) (iter.Seq[CopyPrefixResult], error) {
+	return c.copyPrefix(ctx, srcBucket, srcPrefix, dstBucket, dstPrefix, false, opts)
+}
+
+// MovePrefix is like CopyPrefix but removes every source object once
+// it is successfully copied.
+func (c *Client) MovePrefix(ctx context.Context, srcBucket, srcPrefix, dstBucket, dstPrefix string, opts CopyPrefixOptions) (iter.Seq[CopyPrefixResult], error) {
+	return c.copyPrefix(ctx, srcBucket, srcPrefix, dstBucket, dstPrefix, true, opts)
+}
+
+func (c *Client) copyPrefix(ctx context.Context, srcBucket, srcPrefix, dstBucket, dstPrefix string, move bool, opts CopyPrefixOptions) (iter.Seq[CopyPrefixResult], error) {
+	// Input validation.
+	if err := s3utils.CheckValidBucketName(srcBucket); err != nil {
+		return nil, err
+	}
+	if err := s3utils.CheckValidBucketName(dstBucket); err != nil {
+		return nil, err
+	}
+	// Copying a prefix into itself would list the copies again.
+	if srcBucket == dstBucket && (strings.HasPrefix(dstPrefix, srcPrefix) || strings.HasPrefix(srcPrefix, dstPrefix)) {
+		return nil, errInvalidArgument("Source and destination prefixes cannot overlap within the same bucket")
+	}
+
+	return func(yield func(CopyPrefixResult) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		objectsCh := make(chan ObjectInfo)
+		results := make(chan CopyPrefixResult)
+		go func() {
+			defer close(objectsCh)
+			for object := range c.ListObjectsIter(ctx, srcBucket, ListObjectsOptions{Prefix: srcPrefix, Recursive: true}) {
+				if object.Err != nil {
+					select {
+					case <-ctx.Done():
+					case results <- CopyPrefixResult{SourceObject: srcPrefix, Err: object.Err}:
+					}
+					return
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case objectsCh <- object:
+				}
+			}
+		}()
+
+		var wg sync.WaitGroup
+		for range opts.getNumThreads() {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for object := range objectsCh {
+					result := CopyPrefixResult{
+						SourceObject: object.Key,
+						DestObject:   dstPrefix + strings.TrimPrefix(object.Key, srcPrefix),
+						Size:         object.Size,
+					}
+					result.Info, result.Err = c.copyPrefixObject(ctx, srcBucket, dstBucket, result.DestObject, object, opts)
+					if result.Err == nil && move {
+						result.Err = c.RemoveObject(ctx, srcBucket, object.Key, RemoveObjectOptions{})
+						result.Removed = result.Err == nil
+					}
+					select {
+					case <-ctx.Done():
+						return
+					case results <- result:
+					}
+				}
+			}()
+		}
+		go func() {
+			wg.Wait()
+			close(results)
+		}()
+
+		for result := range results {
+			if !yield(result) {
+				cancel()
+				// Drain the remaining results to let the workers exit.
+				for range results {
+				}
+				return
+			}
+		}
+	}, nil
+}
+
+// copyPrefixObject - copies a single listed object to dstObject.
+func (c *Client) copyPrefixObject(ctx context.Context, srcBucket, dstBucket, dstObject string, object ObjectInfo, opts CopyPrefixOptions) (UploadInfo, error) {
+	src := CopySrcOptions{
+		Bucket:    srcBucket,
+		Object:    object.Key,
+		MatchETag: object.ETag,
+	}
+	dst := CopyDestOptions{
+		Bucket: dstBucket,
+		Object: dstObject,
+	}
+
+	large := object.Size > maxSinglePutObjectSize
+	if !large && !opts.PreserveRetention && !opts.PreserveLegalHold {
+		// CopyObject keeps metadata, tags and content headers.
+		return c.CopyObject(ctx, dst, src)
+	}
+
+	info, err := c.StatObject(ctx, srcBucket, object.Key, StatObjectOptions{})
+	if err != nil {
+		return UploadInfo{}, err
+	}
+	if opts.PreserveRetention {
+		dst.Mode = RetentionMode(info.Metadata.Get(amzLockMode))
+		dst.RetainUntilDate, _ = time.Parse(time.RFC3339, info.Metadata.Get(amzLockRetainUntil))
+	}
+	if opts.PreserveLegalHold {
+		dst.LegalHold = LegalHoldStatus(info.Metadata.Get(amzLegalHoldHeader))
+	}
+	if !large {
+		return c.CopyObject(ctx, dst, src)
+	}
+
+	// Multipart copies start from an empty object, carry over
+	// everything CopyObject would have copied.
+	dst.ContentType = info.ContentType
+	dst.ContentEncoding = info.Metadata.Get("Content-Encoding")
+	dst.ContentDisposition = info.Metadata.Get("Content-Disposition")
+	dst.ContentLanguage = info.Metadata.Get("Content-Language")
+	dst.CacheControl = info.Metadata.Get("Cache-Control")
+	dst.Expires = info.Expires
+	dst.ReplaceMetadata = true
+	dst.UserMetadata = info.UserMetadata
+	dst.ReplaceTags = true
+	dst.UserTags = info.UserTags
+	if info.UserTagCount > 0 && len(info.UserTags) == 0 {
+		t, err := c.GetObjectTagging(ctx, srcBucket, object.Key, GetObjectTaggingOptions{VersionID: info.VersionID})
+		if err != nil {
+			return UploadInfo{}, err
+		}
+		dst.UserTags = t.ToMap()
+	}
+	return c.ComposeObject(ctx, dst, src)
+}
@@ -0,0 +1,313 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestMovePrefix(t *testing.T) {
+	keys := []string{"src/a", "src/b/c", "src/d"}
+
+	var (
+		mu      sync.Mutex
+		copied  = map[string]string{}
+		removed []string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case r.Method == http.MethodGet && r.URL.Query().Get("list-type") == "2":
+			fmt.Fprint(w, "<ListBucketResult><Name>bucket</Name>")
+			for _, key := range keys {
+				fmt.Fprintf(w, "<Contents><Key>%s</Key><Size>1</Size><ETag>\"etag\"</ETag></Contents>", key)
+			}
+			fmt.Fprint(w, "</ListBucketResult>")
+		case r.Method == http.MethodPut && r.Header.Get("X-Amz-Copy-Source") != "":
+			if r.Header.Get("X-Amz-Copy-Source-If-Match") != "etag" {
+				w.WriteHeader(http.StatusPreconditionFailed)
+				return
+			}
+			copied[r.URL.Path] = r.Header.Get("X-Amz-Copy-Source")
+			fmt.Fprint(w, "<CopyObjectResult><ETag>\"etag\"</ETag></CopyObjectResult>")
+		case r.Method == http.MethodDelete:
+			removed = append(removed, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotImplemented)
+		}
+	}))
+	defer srv.Close()
+
+	clnt, err := New(srv.Listener.Addr().String(), &Options{Region: "us-east-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = clnt.MovePrefix(context.Background(), "bucket", "src/", "bucket", "src/dst/", CopyPrefixOptions{}); err == nil {
+		t.Fatal("Expected overlapping prefixes to be rejected")
+	}
+
+	results, err := clnt.MovePrefix(context.Background(), "bucket", "src/", "bucket", "dst/", CopyPrefixOptions{NumThreads: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var moved []string
+	for result := range results {
+		if result.Err != nil {
+			t.Fatal(result.Err)
+		}
+		if !result.Removed {
+			t.Fatalf("Expected %s to be removed", result.SourceObject)
+		}
+		moved = append(moved, result.DestObject)
+	}
+
+	slices.Sort(moved)
+	if want := []string{"dst/a", "dst/b/c", "dst/d"}; !slices.Equal(moved, want) {
+		t.Fatalf("Expected %v, got %v", want, moved)
+	}
+	for _, key := range keys {
+		if got := copied["/bucket/dst/"+key[len("src/"):]]; got != "bucket/"+key {
+			t.Fatalf("Expected copy source bucket/%s, got %q", key, got)
+		}
+	}
+	if len(removed) != len(keys) {
+		t.Fatalf("Expected %d removals, got %v", len(keys), removed)
+	}
+}
+
+func TestCopyPrefixOverlap(t *testing.T) {
+	clnt, err := New("localhost:9000", &Options{Region: "us-east-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		srcBucket, srcPrefix string
+		dstBucket, dstPrefix string
+		overlap              bool
+	}{
+		{"bucket", "a/", "bucket", "a/b/", true},
+		{"bucket", "a/b/", "bucket", "a/", true},
+		{"bucket", "a/", "bucket", "a/", true},
+		{"bucket", "", "bucket", "x/", true},
+		{"bucket", "x/", "bucket", "", true},
+		{"bucket", "a/", "bucket", "b/", false},
+		{"bucket", "a/", "other", "a/b/", false},
+		{"bucket", "", "other", "", false},
+	}
+	for i, testCase := range testCases {
+		// The iterator is lazy, no request is sent unless it is used.
+		_, err := clnt.CopyPrefix(context.Background(), testCase.srcBucket, testCase.srcPrefix,
+			testCase.dstBucket, testCase.dstPrefix, CopyPrefixOptions{})
+		if rejected := ToErrorResponse(err).Code == InvalidArgument; rejected != testCase.overlap {
+			t.Errorf("Test %d: expected overlap %v, got %v", i+1, testCase.overlap, err)
+		}
+	}
+}
+
+func TestCopyPrefixPreserve(t *testing.T) {
+	retainUntil := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+	testCases := []struct {
+		name string
+		size int64
+		opts CopyPrefixOptions
+		// Headers expected on the CopyObject request, or on the
+		// initiate multipart request when composing.
+		want   map[string]string
+		parts  bool
+		stated bool
+	}{
+		{
+			name: "small",
+			size: 1,
+			// The server keeps metadata and tags of a copy.
+			want: map[string]string{
+				"X-Amz-Metadata-Directive": "",
+				"X-Amz-Tagging-Directive":  "",
+				"X-Amz-Tagging":            "",
+			},
+		},
+		{
+			name: "small-retention",
+			size: 1,
+			opts: CopyPrefixOptions{PreserveRetention: true, PreserveLegalHold: true},
+			want: map[string]string{
+				"X-Amz-Metadata-Directive":            "",
+				"X-Amz-Tagging-Directive":             "",
+				"X-Amz-Object-Lock-Mode":              "GOVERNANCE",
+				"X-Amz-Object-Lock-Retain-Until-Date": retainUntil.Format(time.RFC3339),
+				"X-Amz-Object-Lock-Legal-Hold":        "ON",
+			},
+			stated: true,
+		},
+		{
+			name: "large",
+			size: maxSinglePutObjectSize + 1,
+			// A multipart copy starts from an empty object.
+			want: map[string]string{
+				"Content-Type":                 "text/plain",
+				"Cache-Control":                "no-cache",
+				"Content-Language":             "en",
+				"X-Amz-Meta-Color":             "blue",
+				"X-Amz-Tagging":                "k=v",
+				"X-Amz-Object-Lock-Mode":       "",
+				"X-Amz-Object-Lock-Legal-Hold": "",
+			},
+			parts:  true,
+			stated: true,
+		},
+		{
+			name: "large-retention",
+			size: maxSinglePutObjectSize + 1,
+			opts: CopyPrefixOptions{PreserveRetention: true, PreserveLegalHold: true},
+			want: map[string]string{
+				"X-Amz-Meta-Color":                    "blue",
+				"X-Amz-Tagging":                       "k=v",
+				"X-Amz-Object-Lock-Mode":              "GOVERNANCE",
+				"X-Amz-Object-Lock-Retain-Until-Date": retainUntil.Format(time.RFC3339),
+				"X-Amz-Object-Lock-Legal-Hold":        "ON",
+			},
+			parts:  true,
+			stated: true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var (
+				mu      sync.Mutex
+				header  http.Header
+				ranges  []string
+				stated  bool
+				written bool
+			)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+				query := r.URL.Query()
+				switch {
+				case r.Method == http.MethodGet && query.Get("list-type") == "2":
+					fmt.Fprintf(w, "<ListBucketResult><Name>bucket</Name><Contents><Key>src/a</Key><Size>%d</Size><ETag>\"etag\"</ETag></Contents></ListBucketResult>", testCase.size)
+				case r.Method == http.MethodHead && r.URL.Path == "/bucket/src/a":
+					// The size listed is stubbed, only the stat reports it.
+					stated = true
+					h := w.Header()
+					h.Set("Content-Length", strconv.FormatInt(testCase.size, 10))
+					h.Set("ETag", "\"etag\"")
+					h.Set("Last-Modified", time.Now().UTC().Format(http.TimeFormat))
+					h.Set("Content-Type", "text/plain")
+					h.Set("Cache-Control", "no-cache")
+					h.Set("Content-Language", "en")
+					h.Set("X-Amz-Meta-Color", "blue")
+					h.Set("X-Amz-Tagging-Count", "1")
+					h.Set("X-Amz-Object-Lock-Mode", "GOVERNANCE")
+					h.Set("X-Amz-Object-Lock-Retain-Until-Date", retainUntil.Format(time.RFC3339))
+					h.Set("X-Amz-Object-Lock-Legal-Hold", "ON")
+				case r.Method == http.MethodGet && query.Has("tagging"):
+					fmt.Fprint(w, "<Tagging><TagSet><Tag><Key>k</Key><Value>v</Value></Tag></TagSet></Tagging>")
+				case r.Method == http.MethodPost && query.Has("uploads"):
+					header = r.Header.Clone()
+					fmt.Fprint(w, "<InitiateMultipartUploadResult><Bucket>bucket</Bucket><Key>dst/a</Key><UploadId>id</UploadId></InitiateMultipartUploadResult>")
+				case r.Method == http.MethodPut && query.Get("uploadId") == "id":
+					if r.Header.Get("X-Amz-Copy-Source") != "bucket/src/a" || r.Header.Get("X-Amz-Copy-Source-If-Match") != "etag" {
+						w.WriteHeader(http.StatusPreconditionFailed)
+						return
+					}
+					ranges = append(ranges, r.Header.Get("X-Amz-Copy-Source-Range"))
+					fmt.Fprint(w, "<CopyPartResult><ETag>\"part\"</ETag></CopyPartResult>")
+				case r.Method == http.MethodPost && query.Get("uploadId") == "id":
+					written = true
+					fmt.Fprint(w, "<CompleteMultipartUploadResult><Bucket>bucket</Bucket><Key>dst/a</Key><ETag>\"etag-2\"</ETag></CompleteMultipartUploadResult>")
+				case r.Method == http.MethodPut && r.Header.Get("X-Amz-Copy-Source") != "":
+					if r.Header.Get("X-Amz-Copy-Source-If-Match") != "etag" {
+						w.WriteHeader(http.StatusPreconditionFailed)
+						return
+					}
+					header = r.Header.Clone()
+					written = true
+					fmt.Fprint(w, "<CopyObjectResult><ETag>\"etag\"</ETag></CopyObjectResult>")
+				default:
+					w.WriteHeader(http.StatusNotImplemented)
+				}
+			}))
+			defer srv.Close()
+
+			clnt, err := New(srv.Listener.Addr().String(), &Options{Region: "us-east-1"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			results, err := clnt.CopyPrefix(context.Background(), "bucket", "src/", "bucket", "dst/", testCase.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var n int
+			for result := range results {
+				if result.Err != nil {
+					t.Fatal(result.Err)
+				}
+				if result.DestObject != "dst/a" || result.Size != testCase.size {
+					t.Fatalf("Unexpected result %+v", result)
+				}
+				n++
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if n != 1 || !written {
+				t.Fatalf("Expected a single object to be copied, got %d results", n)
+			}
+			if stated != testCase.stated {
+				t.Errorf("Expected stat %v, got %v", testCase.stated, stated)
+			}
+			for key, value := range testCase.want {
+				if got := header.Get(key); got != value {
+					t.Errorf("Expected %s %q, got %q", key, value, got)
+				}
+			}
+			if !testCase.parts {
+				if len(ranges) != 0 {
+					t.Fatalf("Expected no part copy, got %q", ranges)
+				}
+				return
+			}
+			// The parts cover the whole object.
+			var next int64
+			for _, rng := range ranges {
+				var start, end int64
+				if _, err := fmt.Sscanf(rng, "bytes=%d-%d", &start, &end); err != nil || start != next {
+					t.Fatalf("Unexpected part ranges %q", ranges)
+				}
+				next = end + 1
+			}
+			if len(ranges) < 2 || next != testCase.size {
+				t.Fatalf("Expected parts covering %d bytes, got %q", testCase.size, ranges)
+			}
+		})
+	}
+}
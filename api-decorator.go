@@ -0,0 +1,454 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by clientgen from api-interfaces.go. DO NOT EDIT.
+
+package minio
+
+import (
+	"context"
+	"io"
+	"iter"
+	"net/url"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/cors"
+	"github.com/minio/minio-go/v7/pkg/lifecycle"
+	"github.com/minio/minio-go/v7/pkg/notification"
+	"github.com/minio/minio-go/v7/pkg/replication"
+	"github.com/minio/minio-go/v7/pkg/sse"
+	"github.com/minio/minio-go/v7/pkg/tags"
+)
+
+// ClientDecorator is an API forwarding every call to Next. Embed it in
+// a type overriding only the methods to decorate, e.g. to add caching,
+// logging or policies to a Client.
+type ClientDecorator struct {
+	Next API
+}
+
+var _ API = ClientDecorator{}
+
+// GetObject calls d.Next.GetObject.
+func (d ClientDecorator) GetObject(ctx context.Context, bucketName, objectName string, opts GetObjectOptions) (*Object, error) {
+	return d.Next.GetObject(ctx, bucketName, objectName, opts)
+}
+
+// GetObjectParallel calls d.Next.GetObjectParallel.
+func (d ClientDecorator) GetObjectParallel(ctx context.Context, bucketName, objectName string, w io.WriterAt, opts GetObjectOptions) (ObjectInfo, error) {
+	return d.Next.GetObjectParallel(ctx, bucketName, objectName, w, opts)
+}
+
+// FGetObject calls d.Next.FGetObject.
+func (d ClientDecorator) FGetObject(ctx context.Context, bucketName, objectName, filePath string, opts GetObjectOptions) error {
+	return d.Next.FGetObject(ctx, bucketName, objectName, filePath, opts)
+}
+
+// StatObject calls d.Next.StatObject.
+func (d ClientDecorator) StatObject(ctx context.Context, bucketName, objectName string, opts StatObjectOptions) (ObjectInfo, error) {
+	return d.Next.StatObject(ctx, bucketName, objectName, opts)
+}
+
+// GetObjectACL calls d.Next.GetObjectACL.
+func (d ClientDecorator) GetObjectACL(ctx context.Context, bucketName, objectName string) (*ObjectInfo, error) {
+	return d.Next.GetObjectACL(ctx, bucketName, objectName)
+}
+
+// GetObjectAttributes calls d.Next.GetObjectAttributes.
+func (d ClientDecorator) GetObjectAttributes(ctx context.Context, bucketName, objectName string, opts ObjectAttributesOptions) (*ObjectAttributes, error) {
+	return d.Next.GetObjectAttributes(ctx, bucketName, objectName, opts)
+}
+
+// GetObjectTagging calls d.Next.GetObjectTagging.
+func (d ClientDecorator) GetObjectTagging(ctx context.Context, bucketName, objectName string, opts GetObjectTaggingOptions) (*tags.Tags, error) {
+	return d.Next.GetObjectTagging(ctx, bucketName, objectName, opts)
+}
+
+// GetObjectRetention calls d.Next.GetObjectRetention.
+func (d ClientDecorator) GetObjectRetention(ctx context.Context, bucketName, objectName, versionID string) (*RetentionMode, *time.Time, error) {
+	return d.Next.GetObjectRetention(ctx, bucketName, objectName, versionID)
+}
+
+// GetObjectLegalHold calls d.Next.GetObjectLegalHold.
+func (d ClientDecorator) GetObjectLegalHold(ctx context.Context, bucketName, objectName string, opts GetObjectLegalHoldOptions) (*LegalHoldStatus, error) {
+	return d.Next.GetObjectLegalHold(ctx, bucketName, objectName, opts)
+}
+
+// SelectObjectContent calls d.Next.SelectObjectContent.
+func (d ClientDecorator) SelectObjectContent(ctx context.Context, bucketName, objectName string, opts SelectObjectOptions) (*SelectResults, error) {
+	return d.Next.SelectObjectContent(ctx, bucketName, objectName, opts)
+}
+
+// PromptObject calls d.Next.PromptObject.
+func (d ClientDecorator) PromptObject(ctx context.Context, bucketName, objectName, prompt string, opts PromptObjectOptions) (io.ReadCloser, error) {
+	return d.Next.PromptObject(ctx, bucketName, objectName, prompt, opts)
+}
+
+// PresignedGetObject calls d.Next.PresignedGetObject.
+func (d ClientDecorator) PresignedGetObject(ctx context.Context, bucketName, objectName string, expires time.Duration, reqParams url.Values) (*url.URL, error) {
+	return d.Next.PresignedGetObject(ctx, bucketName, objectName, expires, reqParams)
+}
+
+// PresignedHeadObject calls d.Next.PresignedHeadObject.
+func (d ClientDecorator) PresignedHeadObject(ctx context.Context, bucketName, objectName string, expires time.Duration, reqParams url.Values) (*url.URL, error) {
+	return d.Next.PresignedHeadObject(ctx, bucketName, objectName, expires, reqParams)
+}
+
+// PutObject calls d.Next.PutObject.
+func (d ClientDecorator) PutObject(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, opts PutObjectOptions) (UploadInfo, error) {
+	return d.Next.PutObject(ctx, bucketName, objectName, reader, objectSize, opts)
+}
+
+// FPutObject calls d.Next.FPutObject.
+func (d ClientDecorator) FPutObject(ctx context.Context, bucketName, objectName, filePath string, opts PutObjectOptions) (UploadInfo, error) {
+	return d.Next.FPutObject(ctx, bucketName, objectName, filePath, opts)
+}
+
+// AppendObject calls d.Next.AppendObject.
+func (d ClientDecorator) AppendObject(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, opts AppendObjectOptions) (UploadInfo, error) {
+	return d.Next.AppendObject(ctx, bucketName, objectName, reader, objectSize, opts)
+}
+
+// NewObjectWriter calls d.Next.NewObjectWriter.
+func (d ClientDecorator) NewObjectWriter(ctx context.Context, bucketName, objectName string, opts PutObjectOptions) (*ObjectWriter, error) {
+	return d.Next.NewObjectWriter(ctx, bucketName, objectName, opts)
+}
+
+// PutObjectFanOut calls d.Next.PutObjectFanOut.
+func (d ClientDecorator) PutObjectFanOut(ctx context.Context, bucket string, fanOutData io.Reader, fanOutReq PutObjectFanOutRequest) ([]PutObjectFanOutResponse, error) {
+	return d.Next.PutObjectFanOut(ctx, bucket, fanOutData, fanOutReq)
+}
+
+// PutObjectsSnowball calls d.Next.PutObjectsSnowball.
+func (d ClientDecorator) PutObjectsSnowball(ctx context.Context, bucketName string, opts SnowballOptions, objs <-chan SnowballObject) error {
+	return d.Next.PutObjectsSnowball(ctx, bucketName, opts, objs)
+}
+
+// CopyObject calls d.Next.CopyObject.
+func (d ClientDecorator) CopyObject(ctx context.Context, dst CopyDestOptions, src CopySrcOptions) (UploadInfo, error) {
+	return d.Next.CopyObject(ctx, dst, src)
+}
+
+// ComposeObject calls d.Next.ComposeObject.
+func (d ClientDecorator) ComposeObject(ctx context.Context, dst CopyDestOptions, srcs ...CopySrcOptions) (UploadInfo, error) {
+	return d.Next.ComposeObject(ctx, dst, srcs...)
+}
+
+// CopyPrefix calls d.Next.CopyPrefix.
+func (d ClientDecorator) CopyPrefix(ctx context.Context, srcBucket, srcPrefix, dstBucket, dstPrefix string, opts CopyPrefixOptions) (iter.Seq[CopyPrefixResult], error) {
+	return d.Next.CopyPrefix(ctx, srcBucket, srcPrefix, dstBucket, dstPrefix, opts)
+}
+
+// MovePrefix calls d.Next.MovePrefix.
+func (d ClientDecorator) MovePrefix(ctx context.Context, srcBucket, srcPrefix, dstBucket, dstPrefix string, opts CopyPrefixOptions) (iter.Seq[CopyPrefixResult], error) {
+	return d.Next.MovePrefix(ctx, srcBucket, srcPrefix, dstBucket, dstPrefix, opts)
+}
+
+// Mirror calls d.Next.Mirror.
+func (d ClientDecorator) Mirror(ctx context.Context, bucketName, prefix, dir string, opts MirrorOptions) (iter.Seq[MirrorResult], error) {
+	return d.Next.Mirror(ctx, bucketName, prefix, dir, opts)
+}
+
+// RemoveObject calls d.Next.RemoveObject.
+func (d ClientDecorator) RemoveObject(ctx context.Context, bucketName, objectName string, opts RemoveObjectOptions) error {
+	return d.Next.RemoveObject(ctx, bucketName, objectName, opts)
+}
+
+// RemoveObjects calls d.Next.RemoveObjects.
+func (d ClientDecorator) RemoveObjects(ctx context.Context, bucketName string, objectsCh <-chan ObjectInfo, opts RemoveObjectsOptions) <-chan RemoveObjectError {
+	return d.Next.RemoveObjects(ctx, bucketName, objectsCh, opts)
+}
+
+// RemoveObjectsWithResult calls d.Next.RemoveObjectsWithResult.
+func (d ClientDecorator) RemoveObjectsWithResult(ctx context.Context, bucketName string, objectsCh <-chan ObjectInfo, opts RemoveObjectsOptions) <-chan RemoveObjectResult {
+	return d.Next.RemoveObjectsWithResult(ctx, bucketName, objectsCh, opts)
+}
+
+// RemoveObjectsWithIter calls d.Next.RemoveObjectsWithIter.
+func (d ClientDecorator) RemoveObjectsWithIter(ctx context.Context, bucketName string, objectsIter iter.Seq[ObjectInfo], opts RemoveObjectsOptions) (iter.Seq[RemoveObjectResult], error) {
+	return d.Next.RemoveObjectsWithIter(ctx, bucketName, objectsIter, opts)
+}
+
+// RemoveIncompleteUpload calls d.Next.RemoveIncompleteUpload.
+func (d ClientDecorator) RemoveIncompleteUpload(ctx context.Context, bucketName, objectName string) error {
+	return d.Next.RemoveIncompleteUpload(ctx, bucketName, objectName)
+}
+
+// PutObjectTagging calls d.Next.PutObjectTagging.
+func (d ClientDecorator) PutObjectTagging(ctx context.Context, bucketName, objectName string, otags *tags.Tags, opts PutObjectTaggingOptions) error {
+	return d.Next.PutObjectTagging(ctx, bucketName, objectName, otags, opts)
+}
+
+// RemoveObjectTagging calls d.Next.RemoveObjectTagging.
+func (d ClientDecorator) RemoveObjectTagging(ctx context.Context, bucketName, objectName string, opts RemoveObjectTaggingOptions) error {
+	return d.Next.RemoveObjectTagging(ctx, bucketName, objectName, opts)
+}
+
+// PutObjectRetention calls d.Next.PutObjectRetention.
+func (d ClientDecorator) PutObjectRetention(ctx context.Context, bucketName, objectName string, opts PutObjectRetentionOptions) error {
+	return d.Next.PutObjectRetention(ctx, bucketName, objectName, opts)
+}
+
+// PutObjectLegalHold calls d.Next.PutObjectLegalHold.
+func (d ClientDecorator) PutObjectLegalHold(ctx context.Context, bucketName, objectName string, opts PutObjectLegalHoldOptions) error {
+	return d.Next.PutObjectLegalHold(ctx, bucketName, objectName, opts)
+}
+
+// RestoreObject calls d.Next.RestoreObject.
+func (d ClientDecorator) RestoreObject(ctx context.Context, bucketName, objectName, versionID string, req RestoreRequest) error {
+	return d.Next.RestoreObject(ctx, bucketName, objectName, versionID, req)
+}
+
+// PresignedPutObject calls d.Next.PresignedPutObject.
+func (d ClientDecorator) PresignedPutObject(ctx context.Context, bucketName, objectName string, expires time.Duration) (*url.URL, error) {
+	return d.Next.PresignedPutObject(ctx, bucketName, objectName, expires)
+}
+
+// PresignedPostPolicy calls d.Next.PresignedPostPolicy.
+func (d ClientDecorator) PresignedPostPolicy(ctx context.Context, p *PostPolicy) (*url.URL, map[string]string, error) {
+	return d.Next.PresignedPostPolicy(ctx, p)
+}
+
+// ListBuckets calls d.Next.ListBuckets.
+func (d ClientDecorator) ListBuckets(ctx context.Context) ([]BucketInfo, error) {
+	return d.Next.ListBuckets(ctx)
+}
+
+// ListDirectoryBuckets calls d.Next.ListDirectoryBuckets.
+func (d ClientDecorator) ListDirectoryBuckets(ctx context.Context) (iter.Seq2[BucketInfo, error], error) {
+	return d.Next.ListDirectoryBuckets(ctx)
+}
+
+// ListObjects calls d.Next.ListObjects.
+func (d ClientDecorator) ListObjects(ctx context.Context, bucketName string, opts ListObjectsOptions) <-chan ObjectInfo {
+	return d.Next.ListObjects(ctx, bucketName, opts)
+}
+
+// ListObjectsIter calls d.Next.ListObjectsIter.
+func (d ClientDecorator) ListObjectsIter(ctx context.Context, bucketName string, opts ListObjectsOptions) iter.Seq[ObjectInfo] {
+	return d.Next.ListObjectsIter(ctx, bucketName, opts)
+}
+
+// ListIncompleteUploads calls d.Next.ListIncompleteUploads.
+func (d ClientDecorator) ListIncompleteUploads(ctx context.Context, bucketName, objectPrefix string, recursive bool) <-chan ObjectMultipartInfo {
+	return d.Next.ListIncompleteUploads(ctx, bucketName, objectPrefix, recursive)
+}
+
+// MakeBucket calls d.Next.MakeBucket.
+func (d ClientDecorator) MakeBucket(ctx context.Context, bucketName string, opts MakeBucketOptions) error {
+	return d.Next.MakeBucket(ctx, bucketName, opts)
+}
+
+// BucketExists calls d.Next.BucketExists.
+func (d ClientDecorator) BucketExists(ctx context.Context, bucketName string) (bool, error) {
+	return d.Next.BucketExists(ctx, bucketName)
+}
+
+// RemoveBucket calls d.Next.RemoveBucket.
+func (d ClientDecorator) RemoveBucket(ctx context.Context, bucketName string) error {
+	return d.Next.RemoveBucket(ctx, bucketName)
+}
+
+// RemoveBucketWithOptions calls d.Next.RemoveBucketWithOptions.
+func (d ClientDecorator) RemoveBucketWithOptions(ctx context.Context, bucketName string, opts RemoveBucketOptions) error {
+	return d.Next.RemoveBucketWithOptions(ctx, bucketName, opts)
+}
+
+// GetBucketLocation calls d.Next.GetBucketLocation.
+func (d ClientDecorator) GetBucketLocation(ctx context.Context, bucketName string) (string, error) {
+	return d.Next.GetBucketLocation(ctx, bucketName)
+}
+
+// EnableVersioning calls d.Next.EnableVersioning.
+func (d ClientDecorator) EnableVersioning(ctx context.Context, bucketName string) error {
+	return d.Next.EnableVersioning(ctx, bucketName)
+}
+
+// SuspendVersioning calls d.Next.SuspendVersioning.
+func (d ClientDecorator) SuspendVersioning(ctx context.Context, bucketName string) error {
+	return d.Next.SuspendVersioning(ctx, bucketName)
+}
+
+// SetBucketVersioning calls d.Next.SetBucketVersioning.
+func (d ClientDecorator) SetBucketVersioning(ctx context.Context, bucketName string, config BucketVersioningConfiguration) error {
+	return d.Next.SetBucketVersioning(ctx, bucketName, config)
+}
+
+// GetBucketVersioning calls d.Next.GetBucketVersioning.
+func (d ClientDecorator) GetBucketVersioning(ctx context.Context, bucketName string) (BucketVersioningConfiguration, error) {
+	return d.Next.GetBucketVersioning(ctx, bucketName)
+}
+
+// SetBucketPolicy calls d.Next.SetBucketPolicy.
+func (d ClientDecorator) SetBucketPolicy(ctx context.Context, bucketName, policy string) error {
+	return d.Next.SetBucketPolicy(ctx, bucketName, policy)
+}
+
+// GetBucketPolicy calls d.Next.GetBucketPolicy.
+func (d ClientDecorator) GetBucketPolicy(ctx context.Context, bucketName string) (string, error) {
+	return d.Next.GetBucketPolicy(ctx, bucketName)
+}
+
+// SetBucketLifecycle calls d.Next.SetBucketLifecycle.
+func (d ClientDecorator) SetBucketLifecycle(ctx context.Context, bucketName string, config *lifecycle.Configuration) error {
+	return d.Next.SetBucketLifecycle(ctx, bucketName, config)
+}
+
+// GetBucketLifecycle calls d.Next.GetBucketLifecycle.
+func (d ClientDecorator) GetBucketLifecycle(ctx context.Context, bucketName string) (*lifecycle.Configuration, error) {
+	return d.Next.GetBucketLifecycle(ctx, bucketName)
+}
+
+// GetBucketLifecycleWithInfo calls d.Next.GetBucketLifecycleWithInfo.
+func (d ClientDecorator) GetBucketLifecycleWithInfo(ctx context.Context, bucketName string) (*lifecycle.Configuration, time.Time, error) {
+	return d.Next.GetBucketLifecycleWithInfo(ctx, bucketName)
+}
+
+// SetBucketTagging calls d.Next.SetBucketTagging.
+func (d ClientDecorator) SetBucketTagging(ctx context.Context, bucketName string, tags *tags.Tags) error {
+	return d.Next.SetBucketTagging(ctx, bucketName, tags)
+}
+
+// GetBucketTagging calls d.Next.GetBucketTagging.
+func (d ClientDecorator) GetBucketTagging(ctx context.Context, bucketName string) (*tags.Tags, error) {
+	return d.Next.GetBucketTagging(ctx, bucketName)
+}
+
+// RemoveBucketTagging calls d.Next.RemoveBucketTagging.
+func (d ClientDecorator) RemoveBucketTagging(ctx context.Context, bucketName string) error {
+	return d.Next.RemoveBucketTagging(ctx, bucketName)
+}
+
+// SetBucketEncryption calls d.Next.SetBucketEncryption.
+func (d ClientDecorator) SetBucketEncryption(ctx context.Context, bucketName string, config *sse.Configuration) error {
+	return d.Next.SetBucketEncryption(ctx, bucketName, config)
+}
+
+// GetBucketEncryption calls d.Next.GetBucketEncryption.
+func (d ClientDecorator) GetBucketEncryption(ctx context.Context, bucketName string) (*sse.Configuration, error) {
+	return d.Next.GetBucketEncryption(ctx, bucketName)
+}
+
+// RemoveBucketEncryption calls d.Next.RemoveBucketEncryption.
+func (d ClientDecorator) RemoveBucketEncryption(ctx context.Context, bucketName string) error {
+	return d.Next.RemoveBucketEncryption(ctx, bucketName)
+}
+
+// SetBucketCors calls d.Next.SetBucketCors.
+func (d ClientDecorator) SetBucketCors(ctx context.Context, bucketName string, corsConfig *cors.Config) error {
+	return d.Next.SetBucketCors(ctx, bucketName, corsConfig)
+}
+
+// GetBucketCors calls d.Next.GetBucketCors.
+func (d ClientDecorator) GetBucketCors(ctx context.Context, bucketName string) (*cors.Config, error) {
+	return d.Next.GetBucketCors(ctx, bucketName)
+}
+
+// SetBucketObjectLockConfig calls d.Next.SetBucketObjectLockConfig.
+func (d ClientDecorator) SetBucketObjectLockConfig(ctx context.Context, bucketName string, mode *RetentionMode, validity *uint, unit *ValidityUnit) error {
+	return d.Next.SetBucketObjectLockConfig(ctx, bucketName, mode, validity, unit)
+}
+
+// GetBucketObjectLockConfig calls d.Next.GetBucketObjectLockConfig.
+func (d ClientDecorator) GetBucketObjectLockConfig(ctx context.Context, bucketName string) (*RetentionMode, *uint, *ValidityUnit, error) {
+	return d.Next.GetBucketObjectLockConfig(ctx, bucketName)
+}
+
+// SetObjectLockConfig calls d.Next.SetObjectLockConfig.
+func (d ClientDecorator) SetObjectLockConfig(ctx context.Context, bucketName string, mode *RetentionMode, validity *uint, unit *ValidityUnit) error {
+	return d.Next.SetObjectLockConfig(ctx, bucketName, mode, validity, unit)
+}
+
+// GetObjectLockConfig calls d.Next.GetObjectLockConfig.
+func (d ClientDecorator) GetObjectLockConfig(ctx context.Context, bucketName string) (string, *RetentionMode, *uint, *ValidityUnit, error) {
+	return d.Next.GetObjectLockConfig(ctx, bucketName)
+}
+
+// SetBucketReplication calls d.Next.SetBucketReplication.
+func (d ClientDecorator) SetBucketReplication(ctx context.Context, bucketName string, cfg replication.Config) error {
+	return d.Next.SetBucketReplication(ctx, bucketName, cfg)
+}
+
+// GetBucketReplication calls d.Next.GetBucketReplication.
+func (d ClientDecorator) GetBucketReplication(ctx context.Context, bucketName string) (replication.Config, error) {
+	return d.Next.GetBucketReplication(ctx, bucketName)
+}
+
+// RemoveBucketReplication calls d.Next.RemoveBucketReplication.
+func (d ClientDecorator) RemoveBucketReplication(ctx context.Context, bucketName string) error {
+	return d.Next.RemoveBucketReplication(ctx, bucketName)
+}
+
+// CheckBucketReplication calls d.Next.CheckBucketReplication.
+func (d ClientDecorator) CheckBucketReplication(ctx context.Context, bucketName string) error {
+	return d.Next.CheckBucketReplication(ctx, bucketName)
+}
+
+// GetBucketReplicationMetrics calls d.Next.GetBucketReplicationMetrics.
+func (d ClientDecorator) GetBucketReplicationMetrics(ctx context.Context, bucketName string) (replication.Metrics, error) {
+	return d.Next.GetBucketReplicationMetrics(ctx, bucketName)
+}
+
+// GetBucketReplicationMetricsV2 calls d.Next.GetBucketReplicationMetricsV2.
+func (d ClientDecorator) GetBucketReplicationMetricsV2(ctx context.Context, bucketName string) (replication.MetricsV2, error) {
+	return d.Next.GetBucketReplicationMetricsV2(ctx, bucketName)
+}
+
+// ResetBucketReplication calls d.Next.ResetBucketReplication.
+func (d ClientDecorator) ResetBucketReplication(ctx context.Context, bucketName string, olderThan time.Duration) (string, error) {
+	return d.Next.ResetBucketReplication(ctx, bucketName, olderThan)
+}
+
+// ResetBucketReplicationOnTarget calls d.Next.ResetBucketReplicationOnTarget.
+func (d ClientDecorator) ResetBucketReplicationOnTarget(ctx context.Context, bucketName string, olderThan time.Duration, tgtArn string) (replication.ResyncTargetsInfo, error) {
+	return d.Next.ResetBucketReplicationOnTarget(ctx, bucketName, olderThan, tgtArn)
+}
+
+// GetBucketReplicationResyncStatus calls d.Next.GetBucketReplicationResyncStatus.
+func (d ClientDecorator) GetBucketReplicationResyncStatus(ctx context.Context, bucketName, arn string) (replication.ResyncTargetsInfo, error) {
+	return d.Next.GetBucketReplicationResyncStatus(ctx, bucketName, arn)
+}
+
+// CancelBucketReplicationResync calls d.Next.CancelBucketReplicationResync.
+func (d ClientDecorator) CancelBucketReplicationResync(ctx context.Context, bucketName string, tgtArn string) (string, error) {
+	return d.Next.CancelBucketReplicationResync(ctx, bucketName, tgtArn)
+}
+
+// SetBucketNotification calls d.Next.SetBucketNotification.
+func (d ClientDecorator) SetBucketNotification(ctx context.Context, bucketName string, config notification.Configuration) error {
+	return d.Next.SetBucketNotification(ctx, bucketName, config)
+}
+
+// GetBucketNotification calls d.Next.GetBucketNotification.
+func (d ClientDecorator) GetBucketNotification(ctx context.Context, bucketName string) (notification.Configuration, error) {
+	return d.Next.GetBucketNotification(ctx, bucketName)
+}
+
+// RemoveAllBucketNotification calls d.Next.RemoveAllBucketNotification.
+func (d ClientDecorator) RemoveAllBucketNotification(ctx context.Context, bucketName string) error {
+	return d.Next.RemoveAllBucketNotification(ctx, bucketName)
+}
+
+// ListenBucketNotification calls d.Next.ListenBucketNotification.
+func (d ClientDecorator) ListenBucketNotification(ctx context.Context, bucketName, prefix, suffix string, events []string) <-chan notification.Info {
+	return d.Next.ListenBucketNotification(ctx, bucketName, prefix, suffix, events)
+}
+
+// ListenNotification calls d.Next.ListenNotification.
+func (d ClientDecorator) ListenNotification(ctx context.Context, prefix, suffix string, events []string) <-chan notification.Info {
+	return d.Next.ListenNotification(ctx, prefix, suffix, events)
+}
@@ -263,6 +263,18 @@ func errUnexpectedEOF(totalRead, totalSize int64, bucketName, objectName string)
 	}
 }
 
+// errUnexpectedRange - Ranged GET did not return the requested range.
+func errUnexpectedRange(wantRange, gotRange, bucketName, objectName string) error {
+	msg := fmt.Sprintf("Range ‘%s’ was requested, got ‘%s’ instead.", wantRange, gotRange)
+	return ErrorResponse{
+		StatusCode: http.StatusRequestedRangeNotSatisfiable,
+		Code:       InvalidRange,
+		Message:    msg,
+		BucketName: bucketName,
+		Key:        objectName,
+	}
+}
+
 // errInvalidArgument - Invalid argument response.
 func errInvalidArgument(message string) error {
 	return ErrorResponse{
@@ -199,3 +199,19 @@ func (c *Client) GetObjectAttributes(ctx context.Context, bucketName, objectName
 
 	return OA, nil
 }
+
+// checksum returns the type and the base64 encoded value of the first
+// checksum set on the part.
+func (p ObjectAttributePart) checksum() (ChecksumType, string) {
+	switch {
+	case p.ChecksumCRC32C != "":
+		return ChecksumCRC32C, p.ChecksumCRC32C
+	case p.ChecksumCRC32 != "":
+		return ChecksumCRC32, p.ChecksumCRC32
+	case p.ChecksumSHA256 != "":
+		return ChecksumSHA256, p.ChecksumSHA256
+	case p.ChecksumSHA1 != "":
+		return ChecksumSHA1, p.ChecksumSHA1
+	}
+	return ChecksumNone, ""
+}
@@ -0,0 +1,224 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"io"
+	"maps"
+	"net/http"
+	"sync"
+)
+
+// Default number of blocks held by the block cache of an Object.
+const defaultCacheBlocks = 16
+
+// cachedBlock - a single block of an object, data is valid once done
+// is closed and err is nil.
+type cachedBlock struct {
+	done     chan struct{}
+	data     []byte
+	err      error
+	lastUsed uint64
+}
+
+// objectBlockCache - serves reads of an Object from blocks fetched
+// with ranged GET requests, prefetching the next blocks when the
+// object is read sequentially.
+type objectBlockCache struct {
+	c          *Client
+	ctx        context.Context
+	bucketName string
+	objectName string
+	opts       GetObjectOptions
+
+	size      int64
+	blockSize int64
+	maxBlocks int
+	readAhead int
+
+	mu     sync.Mutex
+	blocks map[int64]*cachedBlock
+	tick   uint64
+
+	// End of the previous read, reads starting here are sequential.
+	nextOffset int64
+}
+
+// isBlockCached - returns true if reads of the Object returned by
+// GetObject are served from a block cache.
+func (o GetObjectOptions) isBlockCached() bool {
+	return o.BlockSize > 0 && o.PartNumber == 0 && o.headers[http.CanonicalHeaderKey("Range")] == ""
+}
+
+// newObjectBlockCache - returns the block cache of objectInfo, all
+// blocks are pinned to its ETag and version.
+func (c *Client) newObjectBlockCache(ctx context.Context, bucketName, objectName string, objectInfo ObjectInfo, snowball bool, opts GetObjectOptions) *objectBlockCache {
+	opts.headers = maps.Clone(opts.headers)
+	delete(opts.headers, "Range")
+	if objectInfo.ETag != "" && !snowball {
+		opts.SetMatchETag(objectInfo.ETag)
+	}
+	if opts.VersionID == "" && objectInfo.VersionID != "" {
+		opts.VersionID = objectInfo.VersionID
+	}
+
+	maxBlocks := defaultCacheBlocks
+	if opts.BlockCacheSize > 0 {
+		maxBlocks = int(opts.BlockCacheSize / opts.BlockSize)
+	}
+	// Prefetched blocks must not evict the block being read.
+	maxBlocks = max(maxBlocks, opts.ReadAhead+1)
+
+	return &objectBlockCache{
+		c:          c,
+		ctx:        ctx,
+		bucketName: bucketName,
+		objectName: objectName,
+		opts:       opts,
+		size:       objectInfo.Size,
+		blockSize:  opts.BlockSize,
+		maxBlocks:  maxBlocks,
+		readAhead:  opts.ReadAhead,
+		blocks:     make(map[int64]*cachedBlock),
+		nextOffset: -1,
+	}
+}
+
+// readAt - reads len(b) bytes at offset. Returns io.EOF if fewer bytes
+// are available.
+func (bc *objectBlockCache) readAt(b []byte, offset int64) (n int, err error) {
+	if offset >= bc.size {
+		return 0, io.EOF
+	}
+	sequential := offset == bc.nextOffset
+	defer func() {
+		bc.nextOffset = offset
+	}()
+	for n < len(b) && offset < bc.size {
+		index := offset / bc.blockSize
+		data, err := bc.block(index, sequential)
+		if err != nil {
+			return n, err
+		}
+		m := copy(b[n:], data[offset-index*bc.blockSize:])
+		n += m
+		offset += int64(m)
+	}
+	if n < len(b) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// block - returns the data of block index, fetching it if needed.
+// Sequential reads keep the following blocks coming.
+func (bc *objectBlockCache) block(index int64, sequential bool) ([]byte, error) {
+	bc.mu.Lock()
+	blk := bc.fetch(index)
+	if sequential {
+		for next := index + 1; next <= index+int64(bc.readAhead) && next*bc.blockSize < bc.size; next++ {
+			bc.fetch(next)
+		}
+	}
+	bc.mu.Unlock()
+
+	select {
+	case <-bc.ctx.Done():
+		return nil, bc.ctx.Err()
+	case <-blk.done:
+	}
+	if blk.err != nil {
+		// Let a later read try again.
+		bc.mu.Lock()
+		if bc.blocks[index] == blk {
+			delete(bc.blocks, index)
+		}
+		bc.mu.Unlock()
+		return nil, blk.err
+	}
+	return blk.data, nil
+}
+
+// fetch - returns block index, starting its download if it is not
+// cached. Must be called with mu held.
+func (bc *objectBlockCache) fetch(index int64) *cachedBlock {
+	bc.tick++
+	if blk, ok := bc.blocks[index]; ok {
+		blk.lastUsed = bc.tick
+		return blk
+	}
+	bc.evict()
+
+	blk := &cachedBlock{done: make(chan struct{}), lastUsed: bc.tick}
+	bc.blocks[index] = blk
+
+	start := index * bc.blockSize
+	length := min(bc.blockSize, bc.size-start)
+	go func() {
+		defer close(blk.done)
+		opts := bc.opts
+		opts.headers = maps.Clone(opts.headers)
+		if blk.err = opts.SetRange(start, start+length-1); blk.err != nil {
+			return
+		}
+		reader, _, _, err := bc.c.getObject(bc.ctx, bc.bucketName, bc.objectName, opts)
+		if err != nil {
+			blk.err = err
+			return
+		}
+		defer reader.Close()
+		data := make([]byte, length)
+		if n, err := io.ReadFull(reader, data); err != nil {
+			blk.err = errUnexpectedEOF(int64(n), length, bc.bucketName, bc.objectName)
+			if err != io.ErrUnexpectedEOF && err != io.EOF {
+				blk.err = err
+			}
+			return
+		}
+		blk.data = data
+	}()
+	return blk
+}
+
+// evict - makes room for a new block by dropping the least recently
+// used downloaded block. Must be called with mu held.
+func (bc *objectBlockCache) evict() {
+	for len(bc.blocks) >= bc.maxBlocks {
+		var (
+			oldest    int64 = -1
+			oldestUse uint64
+		)
+		for index, blk := range bc.blocks {
+			select {
+			case <-blk.done:
+			default:
+				// Still downloading.
+				continue
+			}
+			if oldest < 0 || blk.lastUsed < oldestUse {
+				oldest, oldestUse = index, blk.lastUsed
+			}
+		}
+		if oldest < 0 {
+			// All blocks are in flight, allow the cache to grow.
+			return
+		}
+		delete(bc.blocks, oldest)
+	}
+}
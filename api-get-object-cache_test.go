@@ -0,0 +1,97 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGetObjectBlockCache(t *testing.T) {
+	const blockSize = 1000
+	data := make([]byte, 10*blockSize+10)
+	rand.Read(data)
+
+	var gets atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			gets.Add(1)
+		}
+		w.Header().Set("ETag", "\"etag\"")
+		http.ServeContent(w, r, "", time.Now(), bytes.NewReader(data))
+	}))
+	defer srv.Close()
+
+	clnt, err := New(srv.Listener.Addr().String(), &Options{Region: "us-east-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	obj, err := clnt.GetObject(context.Background(), "bucket", "object", GetObjectOptions{
+		BlockSize:      blockSize,
+		BlockCacheSize: 4 * blockSize,
+		ReadAhead:      2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Close()
+
+	// Scattered reads within one block issue a single request.
+	buf := make([]byte, 10)
+	for _, off := range []int64{5010, 5500, 5100, 5990} {
+		if _, err = obj.ReadAt(buf, off); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(buf, data[off:off+10]) {
+			t.Fatalf("Unexpected content at %d", off)
+		}
+	}
+	if n := gets.Load(); n != 1 {
+		t.Fatalf("Expected 1 GET request, got %d", n)
+	}
+
+	// A read spanning a block boundary and the end of the object.
+	buf = make([]byte, 20)
+	n, err := obj.ReadAt(buf, int64(len(data)-15))
+	if err != io.EOF || n != 15 || !bytes.Equal(buf[:n], data[len(data)-15:]) {
+		t.Fatalf("Expected 15 bytes and EOF, got %d %v", n, err)
+	}
+
+	// Sequential reads return the whole object.
+	if _, err = obj.Seek(0, io.SeekStart); err != nil {
+		t.Fatal(err)
+	}
+	got, err := io.ReadAll(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Fatal("Read content does not match")
+	}
+	if n := gets.Load(); n > 1+2+11 {
+		t.Fatalf("Expected blocks to be fetched once, got %d GET requests", n)
+	}
+}
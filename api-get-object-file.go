@@ -22,13 +22,15 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/minio/minio-go/v7/pkg/s3utils"
 )
 
 // FGetObject - download contents of an object to a local file.
 // The options can be used to specify the GET request further.
-func (c *Client) FGetObject(ctx context.Context, bucketName, objectName, filePath string, opts GetObjectOptions) error {
+func (c *Client) FGetObject(ctx context.Context, bucketName, objectName, filePath string, opts GetObjectOptions) (err error) {
 	// Input validation.
 	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
 		return err
@@ -36,6 +38,7 @@ func (c *Client) FGetObject(ctx context.Context, bucketName, objectName, filePat
 	if err := s3utils.CheckValidObjectName(objectName); err != nil {
 		return err
 	}
+	ctx = withBandwidthLimit(ctx, false, opts.BandwidthLimit)
 
 	// Verify if destination already exists.
 	st, err := os.Stat(filePath)
@@ -62,17 +65,42 @@ func (c *Client) FGetObject(ctx context.Context, bucketName, objectName, filePat
 		}
 	}
 
-	// Gather md5sum.
-	objectStat, err := c.StatObject(ctx, bucketName, objectName, StatObjectOptions(opts))
+	// Gather md5sum, and the checksum to verify a complete part file.
+	statOpts := StatObjectOptions(opts)
+	statOpts.Checksum = true
+	objectStat, err := c.StatObject(ctx, bucketName, objectName, statOpts)
 	if err != nil {
 		return err
 	}
 
+	progress := newTransferProgress(opts.ProgressListener, bucketName, objectName)
+	progress.started(objectStat.Size)
+	defer func() {
+		progress.completed(err)
+	}()
+
 	// Write to a temporary file "fileName.part.minio" before saving.
 	filePartPath := filePath + sum256Hex([]byte(objectStat.ETag)) + ".part.minio"
 
+	// Part files of previous versions of the object can never be
+	// resumed, look for them only when resuming a download to keep
+	// downloads into large directories cheap.
+	if _, err = os.Stat(filePartPath); err == nil {
+		if err = removeStalePartFiles(filePath, filePartPath); err != nil {
+			return err
+		}
+	}
+
+	// Parallel downloads write ranges out of order, a partially
+	// written part file cannot be resumed from its size.
+	parallel := opts.isParallel() && objectStat.Size > opts.getPartSize()
+
 	// If exists, open in append mode. If not create it as a part file.
-	filePart, err := os.OpenFile(filePartPath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	fileFlags := os.O_CREATE | os.O_APPEND | os.O_RDWR
+	if parallel {
+		fileFlags = os.O_CREATE | os.O_TRUNC | os.O_WRONLY
+	}
+	filePart, err := os.OpenFile(filePartPath, fileFlags, 0o600)
 	if err != nil {
 		return err
 	}
@@ -88,27 +116,60 @@ func (c *Client) FGetObject(ctx context.Context, bucketName, objectName, filePat
 		}
 	}()
 
-	// Issue Stat to get the current offset.
-	st, err = filePart.Stat()
-	if err != nil {
-		return err
-	}
+	if parallel {
+		// Download all the ranges concurrently into the part file.
+		if err = c.getObjectRanges(ctx, bucketName, objectName, filePart, 0, objectStat, opts); err != nil {
+			return err
+		}
+	} else {
+		// Issue Stat to get the current offset.
+		st, err = filePart.Stat()
+		if err != nil {
+			return err
+		}
 
-	// Initialize get object request headers to set the
-	// appropriate range offsets to read from.
-	if st.Size() > 0 {
-		opts.SetRange(st.Size(), 0)
-	}
+		// Initialize get object request headers to set the
+		// appropriate range offsets to read from.
+		var verified int64
+		if st.Size() > 0 {
+			// Only keep the prefix of the part file which
+			// matches the checksums of the object parts.
+			verified, err = c.verifyPartFile(ctx, bucketName, objectName, filePart, st.Size(), objectStat, opts)
+			if err != nil {
+				return err
+			}
+			if verified < st.Size() {
+				if err = filePart.Truncate(verified); err != nil {
+					return err
+				}
+			}
+			if verified > 0 {
+				opts.SetRange(verified, 0)
+				// Report the resumed bytes as progress.
+				if opts.Progress != nil {
+					if _, err = io.CopyN(io.Discard, opts.Progress, verified); err != nil {
+						return err
+					}
+				}
+				progress.transferred(0, verified)
+			}
+		}
 
-	// Seek to current position for incoming reader.
-	objectReader, objectStat, _, err := c.getObject(ctx, bucketName, objectName, opts)
-	if err != nil {
-		return err
-	}
+		// A verified complete part file has nothing left to download.
+		if verified < objectStat.Size {
+			// Seek to current position for incoming reader.
+			objectReader, objectStat, _, err := c.getObject(ctx, bucketName, objectName, opts)
+			if err != nil {
+				return err
+			}
+			defer objectReader.Close()
 
-	// Write to the part file.
-	if _, err = io.CopyN(filePart, objectReader, objectStat.Size); err != nil {
-		return err
+			// Write to the part file.
+			hooked := progress.part(0, objectStat.Size).reader(newHook(objectReader, opts.Progress))
+			if _, err = io.CopyN(filePart, hooked, objectStat.Size); err != nil {
+				return err
+			}
+		}
 	}
 
 	// Close the file before rename, this is specifically needed for Windows users.
@@ -125,3 +186,158 @@ func (c *Client) FGetObject(ctx context.Context, bucketName, objectName, filePat
 	// Return.
 	return nil
 }
+
+// stalePartFileAge - age after which the part file of another ETag is
+// no longer written by a concurrent download of another version.
+const stalePartFileAge = time.Hour
+
+// removeStalePartFiles - removes the part files of filePath which
+// belong to other ETags than the one of the current part file and
+// were not modified for stalePartFileAge. The part file of a concurrent
+// download, possibly by another process, stalled for longer may still
+// be removed.
+func removeStalePartFiles(filePath, filePartPath string) error {
+	dir, name := filepath.Split(filePath)
+	if dir == "" {
+		dir = "."
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		partName := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(partName, name) || !strings.HasSuffix(partName, ".part.minio") {
+			continue
+		}
+		// Part files are named "<fileName><sha256 hex of etag>.part.minio"
+		etagHash := strings.TrimSuffix(strings.TrimPrefix(partName, name), ".part.minio")
+		if len(etagHash) != 64 || !isHex(etagHash) {
+			continue
+		}
+		partPath := filepath.Join(dir, partName)
+		if partPath == filepath.Clean(filePartPath) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil || time.Since(info.ModTime()) < stalePartFileAge {
+			continue
+		}
+		if err = os.Remove(partPath); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// isHex - returns true if s only consists of lower case hex digits.
+func isHex(s string) bool {
+	for _, r := range s {
+		if (r < '0' || r > '9') && (r < 'a' || r > 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+// verifyPartFile - verifies the first size bytes of a partially
+// downloaded object against the checksums of the object parts and
+// returns the length of the prefix that can be safely resumed from.
+//
+// Complete part files of objects without part checksums, such as
+// single part objects, are verified against the checksum of the whole
+// object instead. If the server provides neither the part file can not
+// be verified and is kept as is.
+func (c *Client) verifyPartFile(ctx context.Context, bucketName, objectName string, filePart io.ReaderAt, size int64, objectStat ObjectInfo, opts GetObjectOptions) (int64, error) {
+	if size > objectStat.Size {
+		// Part file is larger than the object, it can not be resumed.
+		return 0, nil
+	}
+
+	checksumType, checksum := fullObjectChecksum(objectStat.ChecksumCRC32C, objectStat.ChecksumCRC32,
+		objectStat.ChecksumSHA256, objectStat.ChecksumSHA1, objectStat.ChecksumCRC64NVME)
+	attrOpts := ObjectAttributesOptions{
+		VersionID:            objectStat.VersionID,
+		ServerSideEncryption: opts.ServerSideEncryption,
+	}
+	var (
+		offset   int64
+		verified bool
+	)
+parts:
+	for {
+		attrs, err := c.GetObjectAttributes(ctx, bucketName, objectName, attrOpts)
+		if err != nil {
+			// GetObjectAttributes is not supported everywhere,
+			// fall back to the checksum of the stat.
+			break
+		}
+		if attrType, attrChecksum := fullObjectChecksum(attrs.Checksum.ChecksumCRC32C, attrs.Checksum.ChecksumCRC32,
+			attrs.Checksum.ChecksumSHA256, attrs.Checksum.ChecksumSHA1, ""); attrType.IsSet() {
+			checksumType, checksum = attrType, attrChecksum
+		}
+		for _, part := range attrs.ObjectParts.Parts {
+			partType, partChecksum := part.checksum()
+			if !partType.IsSet() {
+				verified = false
+				break parts
+			}
+			partSize := int64(part.Size)
+			if offset+partSize > size {
+				// Partial parts can not be verified, download them again.
+				return offset, nil
+			}
+			got, err := partType.ChecksumReader(io.NewSectionReader(filePart, offset, partSize))
+			if err != nil {
+				return 0, err
+			}
+			if got.Encoded() != partChecksum {
+				return offset, nil
+			}
+			offset += partSize
+			verified = true
+		}
+		if !attrs.ObjectParts.IsTruncated || attrs.ObjectParts.NextPartNumberMarker == 0 {
+			break
+		}
+		attrOpts.PartNumberMarker = attrs.ObjectParts.NextPartNumberMarker
+	}
+	if verified {
+		return offset, nil
+	}
+
+	// Without part checksums only a complete part file can be verified.
+	if size < objectStat.Size || !checksumType.IsSet() {
+		return size, nil
+	}
+	got, err := checksumType.ChecksumReader(io.NewSectionReader(filePart, 0, size))
+	if err != nil {
+		return 0, err
+	}
+	if got.Encoded() != checksum {
+		return 0, nil
+	}
+	return size, nil
+}
+
+// fullObjectChecksum - returns the first checksum computed over the
+// whole content of an object. Composite checksums of multipart objects,
+// suffixed with the number of parts, are checksums of the part
+// checksums and are skipped.
+func fullObjectChecksum(crc32c, crc32, sha256, sha1, crc64nvme string) (ChecksumType, string) {
+	for _, sum := range []struct {
+		checksumType ChecksumType
+		checksum     string
+	}{
+		{ChecksumCRC32C, crc32c},
+		{ChecksumCRC32, crc32},
+		{ChecksumSHA256, sha256},
+		{ChecksumSHA1, sha1},
+		{ChecksumCRC64NVME, crc64nvme},
+	} {
+		if sum.checksum != "" && !strings.Contains(sum.checksum, "-") {
+			return sum.checksumType, sum.checksum
+		}
+	}
+	return ChecksumNone, ""
+}
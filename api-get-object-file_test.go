@@ -0,0 +1,182 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFGetObjectResumeVerified(t *testing.T) {
+	const partSize = 1024
+	data := make([]byte, 3*partSize)
+	rand.Read(data)
+
+	var rangeRequested string
+	modTime := time.Date(2015, 10, 21, 7, 28, 0, 0, time.UTC)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Has("attributes") {
+			w.Header().Set("Last-Modified", modTime.Format(http.TimeFormat))
+			var parts strings.Builder
+			for i := range 3 {
+				sum := ChecksumCRC32C.ChecksumBytes(data[i*partSize : (i+1)*partSize])
+				fmt.Fprintf(&parts, "<Part><ChecksumCRC32C>%s</ChecksumCRC32C><PartNumber>%d</PartNumber><Size>%d</Size></Part>",
+					sum.Encoded(), i+1, partSize)
+			}
+			fmt.Fprintf(w, "<GetObjectAttributesResponse><ObjectParts><PartsCount>3</PartsCount>%s</ObjectParts></GetObjectAttributesResponse>", parts.String())
+			return
+		}
+		if r.Method == http.MethodGet {
+			rangeRequested = r.Header.Get("Range")
+		}
+		w.Header().Set("ETag", "\"etag\"")
+		http.ServeContent(w, r, "", modTime, bytes.NewReader(data))
+	}))
+	defer srv.Close()
+
+	clnt, err := New(srv.Listener.Addr().String(), &Options{Region: "us-east-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	filePath := filepath.Join(dir, "object")
+
+	// Part file left behind for an older version of the object, and the
+	// one of a concurrent download of another version.
+	stalePath := filePath + sum256Hex([]byte("old-etag")) + ".part.minio"
+	if err = os.WriteFile(stalePath, []byte("stale"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	staleTime := time.Now().Add(-2 * stalePartFileAge)
+	if err = os.Chtimes(stalePath, staleTime, staleTime); err != nil {
+		t.Fatal(err)
+	}
+	activePath := filePath + sum256Hex([]byte("new-etag")) + ".part.minio"
+	if err = os.WriteFile(activePath, []byte("active"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	// Downloads which do not resume do not look for them.
+	if err = clnt.FGetObject(context.Background(), "bucket", "object", filePath, GetObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = os.Stat(stalePath); err != nil {
+		t.Fatalf("Expected stale part file to be kept, got %v", err)
+	}
+
+	// Part file with a valid first part, a corrupt second part and
+	// the beginning of the third part.
+	partial := bytes.Clone(data[:2*partSize+100])
+	partial[partSize+10] ^= 0xff
+	partPath := filePath + sum256Hex([]byte("etag")) + ".part.minio"
+	if err = os.WriteFile(partPath, partial, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = clnt.FGetObject(context.Background(), "bucket", "object", filePath, GetObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := fmt.Sprintf("bytes=%d-", partSize); rangeRequested != want {
+		t.Fatalf("Expected range %q, got %q", want, rangeRequested)
+	}
+	got, err := os.ReadFile(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Fatal("Downloaded content does not match")
+	}
+	if _, err = os.Stat(stalePath); !os.IsNotExist(err) {
+		t.Fatalf("Expected stale part file to be removed, got %v", err)
+	}
+	if _, err = os.Stat(activePath); err != nil {
+		t.Fatalf("Expected recent part file to be kept, got %v", err)
+	}
+}
+
+func TestFGetObjectResumeSinglePart(t *testing.T) {
+	data := make([]byte, 2048)
+	rand.Read(data)
+	sum := ChecksumCRC32C.ChecksumBytes(data)
+
+	var gets []string
+	modTime := time.Date(2015, 10, 21, 7, 28, 0, 0, time.UTC)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Has("attributes") {
+			w.Header().Set("Last-Modified", modTime.Format(http.TimeFormat))
+			fmt.Fprintf(w, "<GetObjectAttributesResponse><Checksum><ChecksumCRC32C>%s</ChecksumCRC32C></Checksum></GetObjectAttributesResponse>", sum.Encoded())
+			return
+		}
+		if r.Method == http.MethodGet {
+			gets = append(gets, r.Header.Get("Range"))
+		}
+		w.Header().Set("ETag", "\"etag\"")
+		http.ServeContent(w, r, "", modTime, bytes.NewReader(data))
+	}))
+	defer srv.Close()
+
+	clnt, err := New(srv.Listener.Addr().String(), &Options{Region: "us-east-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	filePath := filepath.Join(t.TempDir(), "object")
+	partPath := filePath + sum256Hex([]byte("etag")) + ".part.minio"
+
+	testCases := []struct {
+		name     string
+		partFile []byte
+		gets     []string
+	}{
+		// A complete but corrupt part file is downloaded again.
+		{name: "corrupt", partFile: append(bytes.Clone(data[:len(data)-1]), data[len(data)-1]^0xff), gets: []string{""}},
+		// A complete valid part file is renamed in place.
+		{name: "complete", partFile: data},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			gets = nil
+			if err := os.WriteFile(partPath, testCase.partFile, 0o600); err != nil {
+				t.Fatal(err)
+			}
+			if err := clnt.FGetObject(context.Background(), "bucket", "object", filePath, GetObjectOptions{}); err != nil {
+				t.Fatal(err)
+			}
+			if fmt.Sprintf("%q", gets) != fmt.Sprintf("%q", testCase.gets) {
+				t.Fatalf("Expected GET requests with ranges %q, got %q", testCase.gets, gets)
+			}
+			got, err := os.ReadFile(filePath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, data) {
+				t.Fatal("Downloaded content does not match")
+			}
+		})
+	}
+}
@@ -0,0 +1,208 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"sync"
+
+	"github.com/minio/minio-go/v7/pkg/s3utils"
+)
+
+// GetObjectParallel downloads an object into w by issuing concurrent
+// ranged GET requests. The number of concurrent requests and the size
+// of each range are controlled by opts.NumThreads and opts.PartSize.
+//
+// All ranged requests are pinned to the ETag (and version) returned by
+// the initial stat, an object overwritten while the download is in
+// progress fails with PreconditionFailed instead of returning mixed
+// content.
+func (c *Client) GetObjectParallel(ctx context.Context, bucketName, objectName string, w io.WriterAt, opts GetObjectOptions) (_ ObjectInfo, err error) {
+	// Input validation.
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return ObjectInfo{}, err
+	}
+	if err := s3utils.CheckValidObjectName(objectName); err != nil {
+		return ObjectInfo{}, err
+	}
+	if opts.PartNumber > 0 {
+		return ObjectInfo{}, errInvalidArgument("PartNumber cannot be combined with a parallel download.")
+	}
+	ctx = withBandwidthLimit(ctx, false, opts.BandwidthLimit)
+
+	statOpts := opts
+	statOpts.headers = maps.Clone(opts.headers)
+	delete(statOpts.headers, "Range")
+	objectInfo, err := c.StatObject(ctx, bucketName, objectName, statOpts)
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+
+	progress := newTransferProgress(opts.ProgressListener, bucketName, objectName)
+	progress.started(objectInfo.Size)
+	defer func() {
+		progress.completed(err)
+	}()
+
+	if err = c.getObjectRanges(ctx, bucketName, objectName, w, 0, objectInfo, opts); err != nil {
+		return ObjectInfo{}, err
+	}
+	return objectInfo, nil
+}
+
+// getRangeReq - a single byte range to be fetched by a download worker.
+type getRangeReq struct {
+	PartNumber int
+	Offset     int64
+	Length     int64
+}
+
+// getObjectRanges - fetches [offset, objectInfo.Size) of the object
+// using concurrent ranged GET requests, writing every range at its
+// absolute offset in w.
+func (c *Client) getObjectRanges(ctx context.Context, bucketName, objectName string, w io.WriterAt, offset int64, objectInfo ObjectInfo, opts GetObjectOptions) error {
+	if offset >= objectInfo.Size {
+		return nil
+	}
+
+	partSize := opts.getPartSize()
+
+	// Pin all the ranges to the object we just looked at.
+	opts.headers = maps.Clone(opts.headers)
+	delete(opts.headers, "Range")
+	var snowball bool
+	if location, ok := c.bucketLocCache.Get(bucketName); ok {
+		snowball = location == "snowball"
+	}
+	if objectInfo.ETag != "" && !snowball {
+		opts.SetMatchETag(objectInfo.ETag)
+	}
+	if opts.VersionID == "" && objectInfo.VersionID != "" {
+		opts.VersionID = objectInfo.VersionID
+	}
+
+	gctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Declare a channel that sends the next range to be downloaded.
+	rangesCh := make(chan getRangeReq)
+	go func() {
+		defer close(rangesCh)
+		for start := offset; start < objectInfo.Size; start += partSize {
+			req := getRangeReq{
+				PartNumber: int(start/partSize) + 1,
+				Offset:     start,
+				Length:     min(partSize, objectInfo.Size-start),
+			}
+			select {
+			case <-gctx.Done():
+				return
+			case rangesCh <- req:
+			}
+		}
+	}()
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	for range opts.getNumThreads() {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for req := range rangesCh {
+				if err := c.getObjectRange(gctx, bucketName, objectName, w, req, objectInfo.Size, opts); err != nil {
+					errOnce.Do(func() {
+						firstErr = err
+						cancel()
+					})
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	// Return an error when the download is canceled.
+	return ctx.Err()
+}
+
+// getObjectRange - downloads a single range of an object of size and
+// writes it at its offset.
+func (c *Client) getObjectRange(ctx context.Context, bucketName, objectName string, w io.WriterAt, req getRangeReq, size int64, opts GetObjectOptions) (err error) {
+	opts.headers = maps.Clone(opts.headers)
+	if err = opts.SetRange(req.Offset, req.Offset+req.Length-1); err != nil {
+		return err
+	}
+
+	progress := newTransferProgress(opts.ProgressListener, bucketName, objectName).part(req.PartNumber, req.Length)
+	progress.started()
+	defer func() {
+		progress.done(err)
+	}()
+
+	resp, _, err := c.getObjectResponse(ctx, bucketName, objectName, opts)
+	if err != nil {
+		return err
+	}
+	defer closeResponse(resp)
+
+	// Servers ignoring the range would write the wrong data at offset.
+	contentRange := resp.Header.Get("Content-Range")
+	wantRange := fmt.Sprintf("bytes %d-%d/%d", req.Offset, req.Offset+req.Length-1, size)
+	if resp.StatusCode != http.StatusPartialContent || contentRange != wantRange {
+		return errUnexpectedRange(wantRange, contentRange, bucketName, objectName)
+	}
+
+	n, err := io.CopyN(io.NewOffsetWriter(w, req.Offset), progress.reader(newHook(resp.Body, opts.Progress)), req.Length)
+	if err == io.EOF {
+		return errUnexpectedEOF(n, req.Length, bucketName, objectName)
+	}
+	return err
+}
+
+// getNumThreads - gets the number of concurrent ranged GET requests
+// used for a parallel download.
+func (o GetObjectOptions) getNumThreads() int {
+	if o.NumThreads > 0 {
+		return int(o.NumThreads)
+	}
+	return totalWorkers
+}
+
+// getPartSize - gets the size of every ranged GET request used for a
+// parallel download.
+func (o GetObjectOptions) getPartSize() int64 {
+	if o.PartSize > 0 {
+		return int64(o.PartSize)
+	}
+	return minPartSize
+}
+
+// isParallel - returns true if the caller asked for a parallel download.
+func (o GetObjectOptions) isParallel() bool {
+	return o.NumThreads > 1 && o.PartNumber == 0 && o.headers[http.CanonicalHeaderKey("Range")] == ""
+}
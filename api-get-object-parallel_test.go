@@ -0,0 +1,162 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newRangeServer(t *testing.T, data []byte, etag func() string) *httptest.Server {
+	t.Helper()
+	modTime := time.Date(2015, 10, 21, 7, 28, 0, 0, time.UTC)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", "\""+etag()+"\"")
+		http.ServeContent(w, r, "", modTime, bytes.NewReader(data))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestGetObjectParallel(t *testing.T) {
+	data := make([]byte, 5*absMinPartSize+123)
+	rand.Read(data)
+
+	srv := newRangeServer(t, data, func() string { return "etag" })
+	clnt, err := New(srv.Listener.Addr().String(), &Options{Region: "us-east-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buf := make([]byte, len(data))
+	info, err := clnt.GetObjectParallel(context.Background(), "bucket", "object", newBufWriterAt(buf), GetObjectOptions{
+		NumThreads: 3,
+		PartSize:   absMinPartSize,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Size != int64(len(data)) {
+		t.Fatalf("Expected size %d, got %d", len(data), info.Size)
+	}
+	if !bytes.Equal(buf, data) {
+		t.Fatal("Downloaded content does not match")
+	}
+}
+
+func TestGetObjectParallelOverwritten(t *testing.T) {
+	data := make([]byte, 4*absMinPartSize)
+	rand.Read(data)
+
+	var requests int32
+	srv := newRangeServer(t, data, func() string {
+		// Object is overwritten after the stat.
+		if atomic.AddInt32(&requests, 1) > 1 {
+			return "etag2"
+		}
+		return "etag1"
+	})
+	clnt, err := New(srv.Listener.Addr().String(), &Options{Region: "us-east-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buf := make([]byte, len(data))
+	_, err = clnt.GetObjectParallel(context.Background(), "bucket", "object", newBufWriterAt(buf), GetObjectOptions{
+		NumThreads: 2,
+		PartSize:   absMinPartSize,
+	})
+	if ToErrorResponse(err).StatusCode != http.StatusPreconditionFailed {
+		t.Fatalf("Expected precondition failed, got %v", err)
+	}
+}
+
+func TestGetObjectParallelRangeIgnored(t *testing.T) {
+	data := make([]byte, 3*absMinPartSize)
+	rand.Read(data)
+
+	modTime := time.Date(2015, 10, 21, 7, 28, 0, 0, time.UTC)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// A proxy answering every request with the whole object.
+		r.Header.Del("Range")
+		w.Header().Set("ETag", "\"etag\"")
+		http.ServeContent(w, r, "", modTime, bytes.NewReader(data))
+	}))
+	defer srv.Close()
+	clnt, err := New(srv.Listener.Addr().String(), &Options{Region: "us-east-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buf := make([]byte, len(data))
+	_, err = clnt.GetObjectParallel(context.Background(), "bucket", "object", newBufWriterAt(buf), GetObjectOptions{
+		NumThreads: 2,
+		PartSize:   absMinPartSize,
+	})
+	if ToErrorResponse(err).Code != InvalidRange {
+		t.Fatalf("Expected %s, got %v", InvalidRange, err)
+	}
+}
+
+func TestFGetObjectParallel(t *testing.T) {
+	data := make([]byte, 3*absMinPartSize+1)
+	rand.Read(data)
+
+	srv := newRangeServer(t, data, func() string { return "etag" })
+	clnt, err := New(srv.Listener.Addr().String(), &Options{Region: "us-east-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	filePath := filepath.Join(t.TempDir(), "object")
+	err = clnt.FGetObject(context.Background(), "bucket", "object", filePath, GetObjectOptions{
+		NumThreads: 4,
+		PartSize:   absMinPartSize,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := os.ReadFile(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Fatal("Downloaded content does not match")
+	}
+}
+
+// bufWriterAt is an io.WriterAt backed by a fixed size buffer.
+type bufWriterAt struct {
+	buf []byte
+}
+
+func newBufWriterAt(buf []byte) *bufWriterAt {
+	return &bufWriterAt{buf: buf}
+}
+
+func (b *bufWriterAt) WriteAt(p []byte, off int64) (int, error) {
+	return copy(b.buf[off:], p), nil
+}
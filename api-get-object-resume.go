@@ -0,0 +1,169 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+)
+
+// errResumeRange - a resumed GET did not return the requested range.
+var errResumeRange = errors.New("resumed GET did not return the requested range")
+
+// resumableReader - the body of a GET request which reissues a ranged
+// GET from the current offset, pinned to the ETag and version of the
+// object, when the connection fails mid-stream.
+type resumableReader struct {
+	c          *Client
+	ctx        context.Context
+	bucketName string
+	objectName string
+	opts       GetObjectOptions
+	etag       string
+
+	body io.ReadCloser
+	// Offset of the next byte of body and the last byte of the
+	// requested range, end is -1 if unknown.
+	offset, end int64
+
+	resumes    int
+	maxResumes int
+
+	// Error of the last failed resume, returned by all further reads.
+	err error
+}
+
+// newResumableReader - returns body, the response of a GET request
+// made with opts, resuming up to opts.MaxResumes times. body is
+// returned as is if it cannot be resumed.
+func (c *Client) newResumableReader(ctx context.Context, bucketName, objectName string, opts GetObjectOptions, objectInfo ObjectInfo, header http.Header, snowball bool, body io.ReadCloser) io.ReadCloser {
+	maxResumes := opts.MaxResumes
+	if maxResumes == 0 {
+		maxResumes = MaxRetry
+	}
+	// Ranges cannot be requested for parts.
+	if maxResumes < 0 || opts.PartNumber > 0 || opts.reqParams.Get("partNumber") != "" {
+		return body
+	}
+
+	r := &resumableReader{
+		c:          c,
+		ctx:        ctx,
+		bucketName: bucketName,
+		objectName: objectName,
+		opts:       opts,
+		body:       body,
+		end:        -1,
+		maxResumes: maxResumes,
+	}
+	var total int64
+	if _, err := fmt.Sscanf(header.Get("Content-Range"), "bytes %d-%d/%d", &r.offset, &r.end, &total); err != nil {
+		if header.Get("Content-Range") != "" {
+			return body
+		}
+		r.offset, r.end = 0, max(objectInfo.Size-1, -1)
+	}
+
+	// Snowball does not support If-Match.
+	if !snowball {
+		r.etag = objectInfo.ETag
+	}
+	if r.opts.VersionID == "" {
+		r.opts.VersionID = objectInfo.VersionID
+	}
+	return r
+}
+
+func (r *resumableReader) Read(p []byte) (n int, err error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+	for {
+		n, err = r.body.Read(p)
+		r.offset += int64(n)
+		if err == nil || err == io.EOF {
+			return n, err
+		}
+		if r.end >= 0 && r.offset > r.end {
+			// Everything has been read.
+			return n, io.EOF
+		}
+		if r.resumes >= r.maxResumes || r.ctx.Err() != nil {
+			return n, err
+		}
+		r.resumes++
+		if r.err = r.resume(); r.err != nil {
+			if r.err == errResumeRange {
+				// Fail with the error of the connection instead.
+				r.err = err
+			}
+			return n, r.err
+		}
+		if n > 0 {
+			return n, nil
+		}
+	}
+}
+
+// resume - replaces the failed body with a ranged GET from the current
+// offset.
+func (r *resumableReader) resume() error {
+	r.body.Close()
+
+	opts := r.opts
+	opts.headers = maps.Clone(r.opts.headers)
+	switch {
+	case r.end >= 0:
+		opts.SetRange(r.offset, r.end)
+	case r.offset > 0:
+		opts.SetRange(r.offset, 0)
+	default:
+		delete(opts.headers, "Range")
+	}
+	if r.etag != "" {
+		opts.SetMatchETag(r.etag)
+	}
+
+	body, _, header, err := r.c.getObject(r.ctx, r.bucketName, r.objectName, opts)
+	if err != nil {
+		r.body = nil
+		return err
+	}
+	// Servers ignoring the range would send the wrong data.
+	var start int64
+	if r.offset > 0 {
+		if _, err = fmt.Sscanf(header.Get("Content-Range"), "bytes %d-", &start); err != nil || start != r.offset {
+			body.Close()
+			r.body = nil
+			return errResumeRange
+		}
+	}
+	r.body = body
+	return nil
+}
+
+func (r *resumableReader) Close() error {
+	if r.body == nil {
+		return nil
+	}
+	return r.body.Close()
+}
@@ -0,0 +1,145 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newDroppingServer - returns a server for data which drops the
+// connection after sending half of the requested range, the first
+// drops GETs fail. The ETag of the object is returned by etag.
+func newDroppingServer(t *testing.T, data []byte, drops int32, etag func() string) (*httptest.Server, *atomic.Int32) {
+	var gets atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", "\""+etag()+"\"")
+		w.Header().Set("Last-Modified", time.Now().UTC().Format(http.TimeFormat))
+		if match := r.Header.Get("If-Match"); match != "" && match != "\""+etag()+"\"" {
+			w.WriteHeader(http.StatusPreconditionFailed)
+			return
+		}
+		start, end := int64(0), int64(len(data)-1)
+		status := http.StatusOK
+		if rng := r.Header.Get("Range"); rng != "" {
+			if _, err := fmt.Sscanf(rng, "bytes=%d-%d", &start, &end); err != nil {
+				end = int64(len(data) - 1)
+			}
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end, len(data)))
+			status = http.StatusPartialContent
+		}
+		body := data[start : end+1]
+		w.Header().Set("Content-Length", fmt.Sprint(len(body)))
+		w.WriteHeader(status)
+		if r.Method != http.MethodGet {
+			return
+		}
+		if gets.Add(1) <= drops {
+			w.Write(body[:len(body)/2])
+			w.(http.Flusher).Flush()
+			conn, _, err := w.(http.Hijacker).Hijack()
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			conn.Close()
+			return
+		}
+		w.Write(body)
+	}))
+	return srv, &gets
+}
+
+func TestGetObjectResume(t *testing.T) {
+	data := make([]byte, 1<<20)
+	rand.Read(data)
+
+	srv, gets := newDroppingServer(t, data, 2, func() string { return "etag" })
+	defer srv.Close()
+
+	clnt, err := New(srv.Listener.Addr().String(), &Options{Region: "us-east-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	obj, err := clnt.GetObject(context.Background(), "bucket", "object", GetObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Close()
+	var buf bytes.Buffer
+	if _, err = io.Copy(&buf, obj); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf.Bytes(), data) {
+		t.Fatal("Read content does not match")
+	}
+	if n := gets.Load(); n != 3 {
+		t.Fatalf("Expected 3 GET requests, got %d", n)
+	}
+
+	// Resuming is disabled.
+	gets.Store(0)
+	obj, err = clnt.GetObject(context.Background(), "bucket", "object", GetObjectOptions{MaxResumes: -1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Close()
+	if _, err = io.Copy(io.Discard, obj); err == nil {
+		t.Fatal("Expected the dropped connection to fail the read")
+	}
+}
+
+func TestGetObjectResumeChangedObject(t *testing.T) {
+	data := make([]byte, 1<<20)
+	rand.Read(data)
+
+	var version atomic.Int32
+	srv, _ := newDroppingServer(t, data, 1, func() string {
+		return fmt.Sprint("etag", version.Load())
+	})
+	defer srv.Close()
+
+	clnt, err := New(srv.Listener.Addr().String(), &Options{Region: "us-east-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	obj, err := clnt.GetObject(context.Background(), "bucket", "object", GetObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Close()
+	// The object is overwritten while it is read.
+	if _, err = obj.Read(make([]byte, 1)); err != nil {
+		t.Fatal(err)
+	}
+	version.Add(1)
+	_, err = io.Copy(io.Discard, obj)
+	if ToErrorResponse(err).StatusCode != http.StatusPreconditionFailed {
+		t.Fatalf("Expected the resume to fail the precondition, got %v", err)
+	}
+}
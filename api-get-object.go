@@ -46,6 +46,7 @@ func (c *Client) GetObject(ctx context.Context, bucketName, objectName string, o
 		}
 	}
 
+	ctx = withBandwidthLimit(ctx, false, opts.BandwidthLimit)
 	gctx, cancel := context.WithCancel(ctx)
 
 	// Detect if snowball is server location we are talking to.
@@ -81,7 +82,33 @@ func (c *Client) GetObject(ctx context.Context, bucketName, objectName string, o
 		// Used to verify if etag of object has changed since last read.
 		var etag string
 
+		// Serves all reads once set.
+		var cache *objectBlockCache
+
 		for req := range reqCh {
+			if req.isReadOp && opts.isBlockCached() {
+				if cache == nil {
+					// The first request needs the object size.
+					if req.isFirstReq {
+						objectInfo, err = c.StatObject(gctx, bucketName, objectName, StatObjectOptions(opts))
+						if err != nil {
+							resCh <- getResponse{Error: err}
+							return
+						}
+						etag = objectInfo.ETag
+					}
+					cache = c.newObjectBlockCache(gctx, bucketName, objectName, objectInfo, snowball, opts)
+				}
+				size, err := cache.readAt(req.Buffer, req.Offset)
+				resCh <- getResponse{
+					objectInfo: objectInfo,
+					Size:       size,
+					Error:      err,
+					didRead:    true,
+				}
+				continue
+			}
+
 			// If this is the first request we may not need to do a getObject request yet.
 			if req.isFirstReq {
 				// First request is a Read/ReadAt.
@@ -96,11 +123,13 @@ func (c *Client) GetObject(ctx context.Context, bucketName, objectName string, o
 					} else if req.Offset > 0 {
 						opts.SetRange(req.Offset, 0)
 					}
-					httpReader, objectInfo, _, err = c.getObject(gctx, bucketName, objectName, opts)
+					var header http.Header
+					httpReader, objectInfo, header, err = c.getObject(gctx, bucketName, objectName, opts)
 					if err != nil {
 						resCh <- getResponse{Error: err}
 						return
 					}
+					httpReader = c.newResumableReader(gctx, bucketName, objectName, opts, objectInfo, header, snowball, httpReader)
 					etag = objectInfo.ETag
 					// Read at least firstReq.Buffer bytes, if not we have
 					// reached our EOF.
@@ -201,13 +230,15 @@ func (c *Client) GetObject(ctx context.Context, bucketName, objectName string, o
 						// Remove range header if already set
 						delete(opts.headers, "Range")
 					}
-					httpReader, objectInfo, _, err = c.getObject(gctx, bucketName, objectName, opts)
+					var header http.Header
+					httpReader, objectInfo, header, err = c.getObject(gctx, bucketName, objectName, opts)
 					if err != nil {
 						resCh <- getResponse{
 							Error: err,
 						}
 						return
 					}
+					httpReader = c.newResumableReader(gctx, bucketName, objectName, opts, objectInfo, header, snowball, httpReader)
 					totalRead = 0
 				}
 
@@ -655,22 +686,35 @@ func newObject(ctx context.Context, cancel context.CancelFunc, reqCh chan<- getR
 // For more information about the HTTP Range header.
 // go to http://www.w3.org/Protocols/rfc2616/rfc2616-sec14.html#sec14.35.
 func (c *Client) getObject(ctx context.Context, bucketName, objectName string, opts GetObjectOptions) (io.ReadCloser, ObjectInfo, http.Header, error) {
+	resp, objectStat, err := c.getObjectResponse(ctx, bucketName, objectName, opts)
+	if err != nil {
+		return nil, ObjectInfo{}, nil, err
+	}
+	// do not close body here, caller will close
+	return resp.Body, objectStat, resp.Header, nil
+}
+
+// getObjectResponse - like getObject, returns the whole response of a
+// successful GET for callers that need its status.
+func (c *Client) getObjectResponse(ctx context.Context, bucketName, objectName string, opts GetObjectOptions) (*http.Response, ObjectInfo, error) {
 	// Validate input arguments.
 	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
-		return nil, ObjectInfo{}, nil, ErrorResponse{
+		return nil, ObjectInfo{}, ErrorResponse{
 			StatusCode: http.StatusBadRequest,
 			Code:       InvalidBucketName,
 			Message:    err.Error(),
 		}
 	}
 	if err := s3utils.CheckValidObjectName(objectName); err != nil {
-		return nil, ObjectInfo{}, nil, ErrorResponse{
+		return nil, ObjectInfo{}, ErrorResponse{
 			StatusCode: http.StatusBadRequest,
 			Code:       XMinioInvalidObjectName,
 			Message:    err.Error(),
 		}
 	}
 
+	ctx = withBandwidthLimit(ctx, false, opts.BandwidthLimit)
+
 	// Execute GET on objectName.
 	resp, err := c.executeMethod(ctx, http.MethodGet, requestMetadata{
 		bucketName:       bucketName,
@@ -680,20 +724,18 @@ func (c *Client) getObject(ctx context.Context, bucketName, objectName string, o
 		contentSHA256Hex: emptySHA256Hex,
 	})
 	if err != nil {
-		return nil, ObjectInfo{}, nil, err
+		return nil, ObjectInfo{}, err
 	}
 	if resp != nil {
 		if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusPartialContent {
-			return nil, ObjectInfo{}, nil, httpRespToErrorResponse(resp, bucketName, objectName)
+			return nil, ObjectInfo{}, httpRespToErrorResponse(resp, bucketName, objectName)
 		}
 	}
 
 	objectStat, err := ToObjectInfo(bucketName, objectName, resp.Header)
 	if err != nil {
 		closeResponse(resp)
-		return nil, ObjectInfo{}, nil, err
+		return nil, ObjectInfo{}, err
 	}
-
-	// do not close body here, caller will close
-	return resp.Body, objectStat, resp.Header, nil
+	return resp, objectStat, nil
 }
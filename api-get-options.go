@@ -19,6 +19,7 @@ package minio
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -48,6 +49,53 @@ type GetObjectOptions struct {
 	// https://docs.aws.amazon.com/AmazonS3/latest/userguide/checking-object-integrity.html
 	Checksum bool
 
+	// Progress is fed the number of bytes downloaded by FGetObject
+	// and GetObjectParallel, the same way PutObjectOptions.Progress
+	// reports uploads.
+	Progress io.Reader
+
+	// NumThreads is the number of concurrent ranged GET requests
+	// issued by GetObjectParallel and FGetObject. FGetObject only
+	// downloads in parallel when NumThreads is greater than 1.
+	// GetObject ignores this value.
+	NumThreads uint
+
+	// PartSize is the size of each ranged GET request issued by
+	// GetObjectParallel and FGetObject, defaults to 16MiB.
+	PartSize uint64
+
+	// BandwidthLimit caps the download throughput of this call in
+	// bytes per second, overriding Options.BandwidthLimit. All the
+	// ranges of a parallel download share the limit.
+	BandwidthLimit int64
+
+	// BlockSize enables a block cache for the Object returned by
+	// GetObject. Read and ReadAt are served from blocks of BlockSize
+	// bytes fetched with ranged GET requests, so scattered small reads
+	// do not each issue a new request. Ignored when a range or part
+	// number is requested.
+	BlockSize int64
+
+	// BlockCacheSize caps the memory held by the block cache, defaults
+	// to 16 blocks.
+	BlockCacheSize int64
+
+	// ReadAhead is the number of blocks fetched concurrently ahead of
+	// sequential reads when the block cache is enabled.
+	ReadAhead int
+
+	// MaxResumes caps how many times reads of the Object returned by
+	// GetObject reissue a ranged GET from the current offset, pinned
+	// to the ETag and version of the object, after the connection
+	// failed mid-stream. Defaults to MaxRetry, negative disables
+	// resuming.
+	MaxResumes int
+
+	// ProgressListener is notified about the progress of FGetObject
+	// and GetObjectParallel, every ranged GET request of a parallel
+	// download is reported as a part.
+	ProgressListener ProgressListener
+
 	// To be not used by external applications
 	Internal AdvancedGetOptions
 }
@@ -0,0 +1,161 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+//go:generate go run ./internal/clientgen -in api-interfaces.go -mock api-mock.go -decorator api-decorator.go
+
+import (
+	"context"
+	"io"
+	"iter"
+	"net/url"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/cors"
+	"github.com/minio/minio-go/v7/pkg/lifecycle"
+	"github.com/minio/minio-go/v7/pkg/notification"
+	"github.com/minio/minio-go/v7/pkg/replication"
+	"github.com/minio/minio-go/v7/pkg/sse"
+	"github.com/minio/minio-go/v7/pkg/tags"
+)
+
+// ObjectReadAPI reads objects and their metadata.
+type ObjectReadAPI interface {
+	GetObject(ctx context.Context, bucketName, objectName string, opts GetObjectOptions) (*Object, error)
+	GetObjectParallel(ctx context.Context, bucketName, objectName string, w io.WriterAt, opts GetObjectOptions) (ObjectInfo, error)
+	FGetObject(ctx context.Context, bucketName, objectName, filePath string, opts GetObjectOptions) error
+	StatObject(ctx context.Context, bucketName, objectName string, opts StatObjectOptions) (ObjectInfo, error)
+	GetObjectACL(ctx context.Context, bucketName, objectName string) (*ObjectInfo, error)
+	GetObjectAttributes(ctx context.Context, bucketName, objectName string, opts ObjectAttributesOptions) (*ObjectAttributes, error)
+	GetObjectTagging(ctx context.Context, bucketName, objectName string, opts GetObjectTaggingOptions) (*tags.Tags, error)
+	GetObjectRetention(ctx context.Context, bucketName, objectName, versionID string) (*RetentionMode, *time.Time, error)
+	GetObjectLegalHold(ctx context.Context, bucketName, objectName string, opts GetObjectLegalHoldOptions) (*LegalHoldStatus, error)
+	SelectObjectContent(ctx context.Context, bucketName, objectName string, opts SelectObjectOptions) (*SelectResults, error)
+	PromptObject(ctx context.Context, bucketName, objectName, prompt string, opts PromptObjectOptions) (io.ReadCloser, error)
+	PresignedGetObject(ctx context.Context, bucketName, objectName string, expires time.Duration, reqParams url.Values) (*url.URL, error)
+	PresignedHeadObject(ctx context.Context, bucketName, objectName string, expires time.Duration, reqParams url.Values) (*url.URL, error)
+}
+
+// ObjectWriteAPI uploads, copies and removes objects and updates their
+// metadata.
+type ObjectWriteAPI interface {
+	PutObject(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, opts PutObjectOptions) (UploadInfo, error)
+	FPutObject(ctx context.Context, bucketName, objectName, filePath string, opts PutObjectOptions) (UploadInfo, error)
+	AppendObject(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, opts AppendObjectOptions) (UploadInfo, error)
+	NewObjectWriter(ctx context.Context, bucketName, objectName string, opts PutObjectOptions) (*ObjectWriter, error)
+	PutObjectFanOut(ctx context.Context, bucket string, fanOutData io.Reader, fanOutReq PutObjectFanOutRequest) ([]PutObjectFanOutResponse, error)
+	PutObjectsSnowball(ctx context.Context, bucketName string, opts SnowballOptions, objs <-chan SnowballObject) error
+	CopyObject(ctx context.Context, dst CopyDestOptions, src CopySrcOptions) (UploadInfo, error)
+	ComposeObject(ctx context.Context, dst CopyDestOptions, srcs ...CopySrcOptions) (UploadInfo, error)
+	CopyPrefix(ctx context.Context, srcBucket, srcPrefix, dstBucket, dstPrefix string, opts CopyPrefixOptions) (iter.Seq[CopyPrefixResult], error)
+	MovePrefix(ctx context.Context, srcBucket, srcPrefix, dstBucket, dstPrefix string, opts CopyPrefixOptions) (iter.Seq[CopyPrefixResult], error)
+	Mirror(ctx context.Context, bucketName, prefix, dir string, opts MirrorOptions) (iter.Seq[MirrorResult], error)
+	RemoveObject(ctx context.Context, bucketName, objectName string, opts RemoveObjectOptions) error
+	RemoveObjects(ctx context.Context, bucketName string, objectsCh <-chan ObjectInfo, opts RemoveObjectsOptions) <-chan RemoveObjectError
+	RemoveObjectsWithResult(ctx context.Context, bucketName string, objectsCh <-chan ObjectInfo, opts RemoveObjectsOptions) <-chan RemoveObjectResult
+	RemoveObjectsWithIter(ctx context.Context, bucketName string, objectsIter iter.Seq[ObjectInfo], opts RemoveObjectsOptions) (iter.Seq[RemoveObjectResult], error)
+	RemoveIncompleteUpload(ctx context.Context, bucketName, objectName string) error
+	PutObjectTagging(ctx context.Context, bucketName, objectName string, otags *tags.Tags, opts PutObjectTaggingOptions) error
+	RemoveObjectTagging(ctx context.Context, bucketName, objectName string, opts RemoveObjectTaggingOptions) error
+	PutObjectRetention(ctx context.Context, bucketName, objectName string, opts PutObjectRetentionOptions) error
+	PutObjectLegalHold(ctx context.Context, bucketName, objectName string, opts PutObjectLegalHoldOptions) error
+	RestoreObject(ctx context.Context, bucketName, objectName, versionID string, req RestoreRequest) error
+	PresignedPutObject(ctx context.Context, bucketName, objectName string, expires time.Duration) (*url.URL, error)
+	PresignedPostPolicy(ctx context.Context, p *PostPolicy) (*url.URL, map[string]string, error)
+}
+
+// ListAPI lists buckets, objects and incomplete uploads.
+type ListAPI interface {
+	ListBuckets(ctx context.Context) ([]BucketInfo, error)
+	ListDirectoryBuckets(ctx context.Context) (iter.Seq2[BucketInfo, error], error)
+	ListObjects(ctx context.Context, bucketName string, opts ListObjectsOptions) <-chan ObjectInfo
+	ListObjectsIter(ctx context.Context, bucketName string, opts ListObjectsOptions) iter.Seq[ObjectInfo]
+	ListIncompleteUploads(ctx context.Context, bucketName, objectPrefix string, recursive bool) <-chan ObjectMultipartInfo
+}
+
+// BucketConfigAPI creates and removes buckets and manages their
+// configuration.
+type BucketConfigAPI interface {
+	MakeBucket(ctx context.Context, bucketName string, opts MakeBucketOptions) error
+	BucketExists(ctx context.Context, bucketName string) (bool, error)
+	RemoveBucket(ctx context.Context, bucketName string) error
+	RemoveBucketWithOptions(ctx context.Context, bucketName string, opts RemoveBucketOptions) error
+	GetBucketLocation(ctx context.Context, bucketName string) (string, error)
+
+	EnableVersioning(ctx context.Context, bucketName string) error
+	SuspendVersioning(ctx context.Context, bucketName string) error
+	SetBucketVersioning(ctx context.Context, bucketName string, config BucketVersioningConfiguration) error
+	GetBucketVersioning(ctx context.Context, bucketName string) (BucketVersioningConfiguration, error)
+
+	SetBucketPolicy(ctx context.Context, bucketName, policy string) error
+	GetBucketPolicy(ctx context.Context, bucketName string) (string, error)
+
+	SetBucketLifecycle(ctx context.Context, bucketName string, config *lifecycle.Configuration) error
+	GetBucketLifecycle(ctx context.Context, bucketName string) (*lifecycle.Configuration, error)
+	GetBucketLifecycleWithInfo(ctx context.Context, bucketName string) (*lifecycle.Configuration, time.Time, error)
+
+	SetBucketTagging(ctx context.Context, bucketName string, tags *tags.Tags) error
+	GetBucketTagging(ctx context.Context, bucketName string) (*tags.Tags, error)
+	RemoveBucketTagging(ctx context.Context, bucketName string) error
+
+	SetBucketEncryption(ctx context.Context, bucketName string, config *sse.Configuration) error
+	GetBucketEncryption(ctx context.Context, bucketName string) (*sse.Configuration, error)
+	RemoveBucketEncryption(ctx context.Context, bucketName string) error
+
+	SetBucketCors(ctx context.Context, bucketName string, corsConfig *cors.Config) error
+	GetBucketCors(ctx context.Context, bucketName string) (*cors.Config, error)
+
+	SetBucketObjectLockConfig(ctx context.Context, bucketName string, mode *RetentionMode, validity *uint, unit *ValidityUnit) error
+	GetBucketObjectLockConfig(ctx context.Context, bucketName string) (*RetentionMode, *uint, *ValidityUnit, error)
+	SetObjectLockConfig(ctx context.Context, bucketName string, mode *RetentionMode, validity *uint, unit *ValidityUnit) error
+	GetObjectLockConfig(ctx context.Context, bucketName string) (string, *RetentionMode, *uint, *ValidityUnit, error)
+
+	SetBucketReplication(ctx context.Context, bucketName string, cfg replication.Config) error
+	GetBucketReplication(ctx context.Context, bucketName string) (replication.Config, error)
+	RemoveBucketReplication(ctx context.Context, bucketName string) error
+	CheckBucketReplication(ctx context.Context, bucketName string) error
+	GetBucketReplicationMetrics(ctx context.Context, bucketName string) (replication.Metrics, error)
+	GetBucketReplicationMetricsV2(ctx context.Context, bucketName string) (replication.MetricsV2, error)
+	ResetBucketReplication(ctx context.Context, bucketName string, olderThan time.Duration) (string, error)
+	ResetBucketReplicationOnTarget(ctx context.Context, bucketName string, olderThan time.Duration, tgtArn string) (replication.ResyncTargetsInfo, error)
+	GetBucketReplicationResyncStatus(ctx context.Context, bucketName, arn string) (replication.ResyncTargetsInfo, error)
+	CancelBucketReplicationResync(ctx context.Context, bucketName string, tgtArn string) (string, error)
+}
+
+// NotificationAPI configures and listens to bucket notifications.
+type NotificationAPI interface {
+	SetBucketNotification(ctx context.Context, bucketName string, config notification.Configuration) error
+	GetBucketNotification(ctx context.Context, bucketName string) (notification.Configuration, error)
+	RemoveAllBucketNotification(ctx context.Context, bucketName string) error
+	ListenBucketNotification(ctx context.Context, bucketName, prefix, suffix string, events []string) <-chan notification.Info
+	ListenNotification(ctx context.Context, prefix, suffix string, events []string) <-chan notification.Info
+}
+
+// API is the S3 API of a Client, without the methods configuring the
+// client itself. Consumers depend on API, or on the narrower interface
+// of the capability they use, to substitute a MockClient in tests or
+// wrap the client in a ClientDecorator.
+type API interface {
+	ObjectReadAPI
+	ObjectWriteAPI
+	ListAPI
+	BucketConfigAPI
+	NotificationAPI
+}
+
+var _ API = (*Client)(nil)
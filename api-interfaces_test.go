@@ -0,0 +1,90 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"testing"
+)
+
+func TestMockClient(t *testing.T) {
+	ctx := context.Background()
+	mock := &MockClient{
+		BucketExistsFunc: func(_ context.Context, bucketName string) (bool, error) {
+			return bucketName == "bucket", nil
+		},
+	}
+	var api ListAPI = mock
+	var config BucketConfigAPI = mock
+	if ok, err := config.BucketExists(ctx, "bucket"); !ok || err != nil {
+		t.Fatalf("expected bucket to exist, got %v, %v", ok, err)
+	}
+
+	// Methods without a function fail.
+	if _, err := api.ListBuckets(ctx); ToErrorResponse(err).Code != APINotSupported {
+		t.Fatalf("expected %s, got %v", APINotSupported, err)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected a panic")
+		}
+	}()
+	api.ListObjects(ctx, "bucket", ListObjectsOptions{})
+}
+
+// countingClient - counts the objects removed through it.
+type countingClient struct {
+	ClientDecorator
+	removed int
+}
+
+func (c *countingClient) RemoveObject(ctx context.Context, bucketName, objectName string, opts RemoveObjectOptions) error {
+	c.removed++
+	return c.Next.RemoveObject(ctx, bucketName, objectName, opts)
+}
+
+func TestClientDecorator(t *testing.T) {
+	ctx := context.Background()
+	var got []string
+	mock := &MockClient{
+		RemoveObjectFunc: func(_ context.Context, bucketName, objectName string, _ RemoveObjectOptions) error {
+			got = append(got, bucketName+"/"+objectName)
+			return nil
+		},
+		ComposeObjectFunc: func(_ context.Context, _ CopyDestOptions, srcs ...CopySrcOptions) (UploadInfo, error) {
+			return UploadInfo{Size: int64(len(srcs))}, nil
+		},
+	}
+	c := &countingClient{ClientDecorator: ClientDecorator{Next: mock}}
+	var api API = c
+	if err := api.RemoveObject(ctx, "bucket", "object", RemoveObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if c.removed != 1 || len(got) != 1 || got[0] != "bucket/object" {
+		t.Fatalf("unexpected calls %d, %v", c.removed, got)
+	}
+
+	// Other methods are forwarded as is.
+	info, err := api.ComposeObject(ctx, CopyDestOptions{}, CopySrcOptions{}, CopySrcOptions{})
+	if err != nil || info.Size != 2 {
+		t.Fatalf("unexpected compose %+v, %v", info, err)
+	}
+	if _, err = api.StatObject(ctx, "bucket", "object", StatObjectOptions{}); ToErrorResponse(err).Code != APINotSupported {
+		t.Fatalf("expected %s, got %v", APINotSupported, err)
+	}
+}
@@ -0,0 +1,426 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"io"
+	"io/fs"
+	"iter"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/s3utils"
+)
+
+// MirrorDirection is the direction in which Mirror copies data.
+type MirrorDirection int
+
+const (
+	// MirrorUpload makes the bucket prefix look like the local directory.
+	MirrorUpload MirrorDirection = iota
+	// MirrorDownload makes the local directory look like the bucket prefix.
+	MirrorDownload
+)
+
+// MirrorAction is the action taken by Mirror for a single entry.
+type MirrorAction string
+
+const (
+	// MirrorActionUpload uploads a local file to the bucket.
+	MirrorActionUpload MirrorAction = "upload"
+	// MirrorActionDownload downloads an object to the local directory.
+	MirrorActionDownload MirrorAction = "download"
+	// MirrorActionRemove removes an object or a local file which
+	// does not exist on the source side.
+	MirrorActionRemove MirrorAction = "remove"
+)
+
+// MirrorOptions are used to configure a Mirror call.
+type MirrorOptions struct {
+	// Direction in which data is copied, defaults to MirrorUpload.
+	Direction MirrorDirection
+
+	// NumThreads is the number of concurrent transfers, defaults to 4.
+	NumThreads int
+
+	// DryRun only reports the actions which would be taken.
+	DryRun bool
+
+	// Remove deletes entries on the target side which do not
+	// exist on the source side.
+	Remove bool
+
+	// Include and Exclude are path.Match patterns matched against
+	// the slash separated path relative to the directory and the
+	// prefix, as well as against its base name. If Include is set
+	// only matching entries are mirrored, entries matching Exclude
+	// are always skipped.
+	Include []string
+	Exclude []string
+
+	// CompareChecksum compares the MD5 of local files against the
+	// ETag of single part objects instead of relying on the
+	// modification time. Size is always compared.
+	CompareChecksum bool
+
+	// PutOptions are used for every upload.
+	PutOptions PutObjectOptions
+	// GetOptions are used for every download.
+	GetOptions GetObjectOptions
+}
+
+// getNumThreads - gets the number of concurrent transfers.
+func (opts MirrorOptions) getNumThreads() int {
+	if opts.NumThreads > 0 {
+		return opts.NumThreads
+	}
+	return totalWorkers
+}
+
+// included - returns true if the relative path passes the include
+// and exclude patterns.
+func (opts MirrorOptions) included(rel string) bool {
+	match := func(patterns []string) bool {
+		for _, pattern := range patterns {
+			if ok, _ := path.Match(pattern, rel); ok {
+				return true
+			}
+			if ok, _ := path.Match(pattern, path.Base(rel)); ok {
+				return true
+			}
+		}
+		return false
+	}
+	if len(opts.Include) > 0 && !match(opts.Include) {
+		return false
+	}
+	return !match(opts.Exclude)
+}
+
+// MirrorResult is the outcome of a single Mirror action.
+type MirrorResult struct {
+	Action     MirrorAction
+	ObjectName string
+	Path       string
+	Size       int64
+	DryRun     bool
+	Err        error
+}
+
+// mirrorEntry - a file or an object taking part in a mirror, keyed
+// by its slash separated path relative to the directory or prefix.
+type mirrorEntry struct {
+	Size    int64
+	ModTime time.Time
+	ETag    string
+}
+
+// Mirror reconciles the local directory dir with the objects under
+// prefix in bucketName. Entries missing on the target side or whose
+// size or modification time (or checksum, see CompareChecksum)
+// differ are copied, and with opts.Remove set entries that only
+// exist on the target side are removed.
+//
+// Results are returned as they complete. Stopping the iteration or
+// canceling the context stops the mirror.
+func (c *Client) Mirror(ctx context.Context, bucketName, prefix, dir string, opts MirrorOptions) (iter.Seq[MirrorResult], error) {
+	// Validate if bucket name is valid.
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return nil, err
+	}
+	if dir == "" {
+		return nil, errInvalidArgument("Mirror directory cannot be empty")
+	}
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+
+	return func(yield func(MirrorResult) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		local, err := mirrorLocalEntries(dir, opts)
+		if err != nil {
+			yield(MirrorResult{Path: dir, Err: err})
+			return
+		}
+		remote := make(map[string]mirrorEntry)
+		for object := range c.ListObjectsIter(ctx, bucketName, ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+			if object.Err != nil {
+				yield(MirrorResult{ObjectName: prefix, Err: object.Err})
+				return
+			}
+			rel := strings.TrimPrefix(object.Key, prefix)
+			if rel == "" || strings.HasSuffix(rel, "/") || !opts.included(rel) {
+				continue
+			}
+			remote[rel] = mirrorEntry{Size: object.Size, ModTime: object.LastModified, ETag: object.ETag}
+		}
+
+		source, target := local, remote
+		if opts.Direction == MirrorDownload {
+			source, target = remote, local
+		}
+		copies, removals := planMirror(source, target, opts, func(rel, etag string) bool {
+			return localMatchesETag(filepath.Join(dir, filepath.FromSlash(rel)), etag)
+		})
+
+		results := make(chan MirrorResult)
+		go func() {
+			defer close(results)
+			c.mirrorCopy(ctx, bucketName, prefix, dir, copies, source, results, opts)
+			if opts.Remove {
+				c.mirrorRemove(ctx, bucketName, prefix, dir, removals, target, results, opts)
+			}
+		}()
+		for result := range results {
+			if !yield(result) {
+				cancel()
+				// Drain the remaining results to let the workers exit.
+				for range results {
+				}
+				return
+			}
+		}
+	}, nil
+}
+
+// planMirror - returns the sorted relative paths which have to be
+// copied from source to target and which only exist on the target.
+func planMirror(source, target map[string]mirrorEntry, opts MirrorOptions, sameChecksum func(rel, etag string) bool) (copies, removals []string) {
+	for rel, src := range source {
+		dst, ok := target[rel]
+		if !ok || src.Size != dst.Size {
+			copies = append(copies, rel)
+			continue
+		}
+		// Only the remote side carries an ETag.
+		etag := src.ETag
+		if etag == "" {
+			etag = dst.ETag
+		}
+		if opts.CompareChecksum && isPlainMD5(etag) {
+			if !sameChecksum(rel, etag) {
+				copies = append(copies, rel)
+			}
+			continue
+		}
+		if src.ModTime.After(dst.ModTime) {
+			copies = append(copies, rel)
+		}
+	}
+	for rel := range target {
+		if _, ok := source[rel]; !ok {
+			removals = append(removals, rel)
+		}
+	}
+	sort.Strings(copies)
+	sort.Strings(removals)
+	return copies, removals
+}
+
+// isPlainMD5 - returns true if etag is the MD5 of the object data,
+// which is not the case for multipart or encrypted objects.
+func isPlainMD5(etag string) bool {
+	return len(etag) == 32 && isHex(etag)
+}
+
+// localMatchesETag - returns true if the MD5 of the file matches etag.
+func localMatchesETag(filePath, etag string) bool {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+	h := md5.New()
+	if _, err = io.Copy(h, f); err != nil {
+		return false
+	}
+	return hex.EncodeToString(h.Sum(nil)) == etag
+}
+
+// mirrorLocalEntries - collects all regular files below dir.
+func mirrorLocalEntries(dir string, opts MirrorOptions) (map[string]mirrorEntry, error) {
+	entries := make(map[string]mirrorEntry)
+	if opts.Direction == MirrorDownload {
+		if _, err := os.Stat(dir); os.IsNotExist(err) {
+			return entries, nil
+		}
+	}
+	err := filepath.WalkDir(dir, func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, filePath)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		// Skip temporary files of interrupted downloads.
+		if strings.HasSuffix(rel, ".part.minio") || !opts.included(rel) {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		entries[rel] = mirrorEntry{Size: info.Size(), ModTime: info.ModTime()}
+		return nil
+	})
+	return entries, err
+}
+
+// mirrorCopy - uploads or downloads all the entries in copies using
+// a bounded number of workers.
+func (c *Client) mirrorCopy(ctx context.Context, bucketName, prefix, dir string, copies []string, source map[string]mirrorEntry, results chan<- MirrorResult, opts MirrorOptions) {
+	copiesCh := make(chan string)
+	go func() {
+		defer close(copiesCh)
+		for _, rel := range copies {
+			select {
+			case <-ctx.Done():
+				return
+			case copiesCh <- rel:
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for range opts.getNumThreads() {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for rel := range copiesCh {
+				result := MirrorResult{
+					Action:     MirrorActionUpload,
+					ObjectName: prefix + rel,
+					Path:       filepath.Join(dir, filepath.FromSlash(rel)),
+					Size:       source[rel].Size,
+					DryRun:     opts.DryRun,
+				}
+				if opts.Direction == MirrorDownload {
+					result.Action = MirrorActionDownload
+				}
+				if !opts.DryRun {
+					result.Err = c.mirrorCopyOne(ctx, bucketName, dir, result, source[rel], opts)
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case results <- result:
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// mirrorCopyOne - copies a single entry.
+func (c *Client) mirrorCopyOne(ctx context.Context, bucketName, dir string, result MirrorResult, src mirrorEntry, opts MirrorOptions) error {
+	if result.Action == MirrorActionUpload {
+		putOpts := opts.PutOptions
+		putOpts.UserMetadata = maps.Clone(opts.PutOptions.UserMetadata)
+		_, err := c.FPutObject(ctx, bucketName, result.ObjectName, result.Path, putOpts)
+		return err
+	}
+
+	// Never write outside of the mirrored directory.
+	if !isWithinDir(dir, result.Path) {
+		return errInvalidArgument("Object name " + result.ObjectName + " resolves outside of " + dir)
+	}
+	if err := c.FGetObject(ctx, bucketName, result.ObjectName, result.Path, opts.GetOptions); err != nil {
+		return err
+	}
+	// Keep the modification time of the object, so the next mirror
+	// considers both sides equal.
+	return os.Chtimes(result.Path, src.ModTime, src.ModTime)
+}
+
+// isWithinDir - returns true if filePath is located below dir.
+func isWithinDir(dir, filePath string) bool {
+	rel, err := filepath.Rel(dir, filePath)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// mirrorRemove - removes all the entries in removals from the target.
+func (c *Client) mirrorRemove(ctx context.Context, bucketName, prefix, dir string, removals []string, target map[string]mirrorEntry, results chan<- MirrorResult, opts MirrorOptions) {
+	send := func(result MirrorResult) bool {
+		select {
+		case <-ctx.Done():
+			return false
+		case results <- result:
+			return true
+		}
+	}
+
+	if opts.Direction == MirrorDownload || opts.DryRun {
+		for _, rel := range removals {
+			result := MirrorResult{
+				Action:     MirrorActionRemove,
+				ObjectName: prefix + rel,
+				Path:       filepath.Join(dir, filepath.FromSlash(rel)),
+				Size:       target[rel].Size,
+				DryRun:     opts.DryRun,
+			}
+			if !opts.DryRun {
+				result.Err = os.Remove(result.Path)
+			}
+			if !send(result) {
+				return
+			}
+		}
+		return
+	}
+
+	objects := func(yield func(ObjectInfo) bool) {
+		for _, rel := range removals {
+			if !yield(ObjectInfo{Key: prefix + rel}) {
+				return
+			}
+		}
+	}
+	removed, err := c.RemoveObjectsWithIter(ctx, bucketName, objects, RemoveObjectsOptions{})
+	if err != nil {
+		send(MirrorResult{Action: MirrorActionRemove, ObjectName: prefix, Err: err})
+		return
+	}
+	for res := range removed {
+		rel := strings.TrimPrefix(res.ObjectName, prefix)
+		if !send(MirrorResult{
+			Action:     MirrorActionRemove,
+			ObjectName: res.ObjectName,
+			Path:       filepath.Join(dir, filepath.FromSlash(rel)),
+			Size:       target[rel].Size,
+			Err:        res.Err,
+		}) {
+			return
+		}
+	}
+}
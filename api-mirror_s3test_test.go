@@ -0,0 +1,193 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/s3test"
+)
+
+// mirror - runs Mirror and returns its results as sorted
+// "action name" strings, failing on errors.
+func mirror(t *testing.T, client *minio.Client, dir string, opts minio.MirrorOptions) []string {
+	t.Helper()
+	results, err := client.Mirror(context.Background(), "bucket", "prefix", dir, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var actions []string
+	for result := range results {
+		if result.Err != nil {
+			t.Fatalf("%s %s: %v", result.Action, result.ObjectName, result.Err)
+		}
+		actions = append(actions, fmt.Sprint(result.Action, " ", result.ObjectName))
+	}
+	sort.Strings(actions)
+	return actions
+}
+
+// writeFiles - writes files below dir, last modified before any object
+// uploaded by the test.
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	modTime := time.Now().Add(-time.Hour)
+	for name, data := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(path, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func expectActions(t *testing.T, got []string, want ...string) {
+	t.Helper()
+	if fmt.Sprintf("%q", got) != fmt.Sprintf("%q", want) {
+		t.Fatalf("expected actions %q, got %q", want, got)
+	}
+}
+
+func TestMirrorS3Test(t *testing.T) {
+	ctx := context.Background()
+	srv := s3test.NewServer(nil)
+	defer srv.Close()
+	client, err := srv.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = client.MakeBucket(ctx, "bucket", minio.MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Upload a local tree.
+	src := t.TempDir()
+	files := map[string]string{"a.txt": "a", "dir/b.txt": "bb", "dir/sub/c.txt": "ccc"}
+	writeFiles(t, src, files)
+	expectActions(t, mirror(t, client, src, minio.MirrorOptions{}),
+		"upload prefix/a.txt", "upload prefix/dir/b.txt", "upload prefix/dir/sub/c.txt")
+
+	// Mirroring again is a no-op.
+	expectActions(t, mirror(t, client, src, minio.MirrorOptions{}))
+
+	// Download the objects into another tree, then again as a no-op.
+	dst := filepath.Join(t.TempDir(), "dst")
+	downloadOpts := minio.MirrorOptions{Direction: minio.MirrorDownload}
+	expectActions(t, mirror(t, client, dst, downloadOpts),
+		"download prefix/a.txt", "download prefix/dir/b.txt", "download prefix/dir/sub/c.txt")
+	for name, data := range files {
+		got, err := os.ReadFile(filepath.Join(dst, filepath.FromSlash(name)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != data {
+			t.Fatalf("%s: expected %q, got %q", name, data, got)
+		}
+	}
+	expectActions(t, mirror(t, client, dst, downloadOpts))
+
+	// A dry run reports the actions without taking them.
+	if err = os.Remove(filepath.Join(src, "a.txt")); err != nil {
+		t.Fatal(err)
+	}
+	writeFiles(t, src, map[string]string{"d.txt": "dddd"})
+	dryRunOpts := minio.MirrorOptions{Remove: true, DryRun: true}
+	expectActions(t, mirror(t, client, src, dryRunOpts), "remove prefix/a.txt", "upload prefix/d.txt")
+	expectActions(t, mirror(t, client, src, dryRunOpts), "remove prefix/a.txt", "upload prefix/d.txt")
+
+	// Remove deletes the objects missing locally.
+	expectActions(t, mirror(t, client, src, minio.MirrorOptions{Remove: true}), "remove prefix/a.txt", "upload prefix/d.txt")
+	var keys []string
+	for object := range client.ListObjectsIter(ctx, "bucket", minio.ListObjectsOptions{Recursive: true}) {
+		if object.Err != nil {
+			t.Fatal(object.Err)
+		}
+		keys = append(keys, object.Key)
+	}
+	if want := "[prefix/d.txt prefix/dir/b.txt prefix/dir/sub/c.txt]"; fmt.Sprint(keys) != want {
+		t.Fatalf("expected %s, got %v", want, keys)
+	}
+
+	// And the local files missing remotely when downloading.
+	expectActions(t, mirror(t, client, dst, minio.MirrorOptions{Direction: minio.MirrorDownload, Remove: true}),
+		"download prefix/d.txt", "remove prefix/a.txt")
+	if _, err = os.Stat(filepath.Join(dst, "a.txt")); !os.IsNotExist(err) {
+		t.Fatalf("expected a.txt to be removed, got %v", err)
+	}
+}
+
+func TestMirrorS3TestPathTraversal(t *testing.T) {
+	ctx := context.Background()
+	srv := s3test.NewServer(nil)
+	defer srv.Close()
+	client, err := srv.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = client.MakeBucket(ctx, "bucket", minio.MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"prefix/ok.txt", "prefix/../x"} {
+		if _, err = client.PutObject(ctx, "bucket", key, strings.NewReader("data"), 4, minio.PutObjectOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	parent := t.TempDir()
+	dir := filepath.Join(parent, "dst")
+	results, err := client.Mirror(ctx, "bucket", "prefix", dir, minio.MirrorOptions{Direction: minio.MirrorDownload})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var rejected bool
+	for result := range results {
+		switch result.ObjectName {
+		case "prefix/ok.txt":
+			if result.Err != nil {
+				t.Fatal(result.Err)
+			}
+		case "prefix/../x":
+			rejected = minio.ToErrorResponse(result.Err).Code == minio.InvalidArgument
+		default:
+			t.Fatalf("unexpected result %+v", result)
+		}
+	}
+	if !rejected {
+		t.Fatal("expected prefix/../x to be rejected")
+	}
+	if _, err = os.Stat(filepath.Join(parent, "x")); !os.IsNotExist(err) {
+		t.Fatalf("expected nothing to be written outside of the directory, got %v", err)
+	}
+	if got, err := os.ReadFile(filepath.Join(dir, "ok.txt")); err != nil || !bytes.Equal(got, []byte("data")) {
+		t.Fatalf("expected ok.txt to be downloaded, got %q, %v", got, err)
+	}
+}
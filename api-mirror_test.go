@@ -0,0 +1,87 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestMirrorIncluded(t *testing.T) {
+	testCases := []struct {
+		include, exclude []string
+		rel              string
+		expected         bool
+	}{
+		{nil, nil, "a/b.txt", true},
+		{[]string{"*.txt"}, nil, "a/b.txt", true},
+		{[]string{"*.txt"}, nil, "a/b.log", false},
+		{[]string{"a/*"}, nil, "a/b.log", true},
+		{nil, []string{"*.log"}, "a/b.log", false},
+		{[]string{"a/*"}, []string{"*.log"}, "a/b.log", false},
+	}
+	for i, testCase := range testCases {
+		opts := MirrorOptions{Include: testCase.include, Exclude: testCase.exclude}
+		if got := opts.included(testCase.rel); got != testCase.expected {
+			t.Errorf("Test %d: expected %v, got %v", i+1, testCase.expected, got)
+		}
+	}
+}
+
+func TestPlanMirror(t *testing.T) {
+	now := time.Now()
+	local := map[string]mirrorEntry{
+		"same":     {Size: 1, ModTime: now.Add(-time.Hour)},
+		"newer":    {Size: 1, ModTime: now},
+		"resized":  {Size: 2, ModTime: now.Add(-time.Hour)},
+		"missing":  {Size: 1, ModTime: now},
+		"checksum": {Size: 1, ModTime: now},
+	}
+	remote := map[string]mirrorEntry{
+		"same":     {Size: 1, ModTime: now.Add(-time.Minute), ETag: "etag"},
+		"newer":    {Size: 1, ModTime: now.Add(-time.Minute), ETag: "etag"},
+		"resized":  {Size: 1, ModTime: now, ETag: "etag"},
+		"checksum": {Size: 1, ModTime: now.Add(-time.Minute), ETag: "0cc175b9c0f1b6a831c399e269772661"},
+		"extra":    {Size: 1, ModTime: now, ETag: "etag"},
+	}
+	sameChecksum := func(string, string) bool { return true }
+
+	copies, removals := planMirror(local, remote, MirrorOptions{}, sameChecksum)
+	if want := []string{"checksum", "missing", "newer", "resized"}; !reflect.DeepEqual(copies, want) {
+		t.Fatalf("Expected copies %v, got %v", want, copies)
+	}
+	if want := []string{"extra"}; !reflect.DeepEqual(removals, want) {
+		t.Fatalf("Expected removals %v, got %v", want, removals)
+	}
+
+	// With checksums a matching MD5 wins over the modification time.
+	copies, _ = planMirror(local, remote, MirrorOptions{CompareChecksum: true}, sameChecksum)
+	if want := []string{"missing", "newer", "resized"}; !reflect.DeepEqual(copies, want) {
+		t.Fatalf("Expected copies %v, got %v", want, copies)
+	}
+
+	// Downloads compare in the other direction.
+	copies, removals = planMirror(remote, local, MirrorOptions{Direction: MirrorDownload}, sameChecksum)
+	if want := []string{"extra", "resized", "same"}; !reflect.DeepEqual(copies, want) {
+		t.Fatalf("Expected copies %v, got %v", want, copies)
+	}
+	if want := []string{"missing"}; !reflect.DeepEqual(removals, want) {
+		t.Fatalf("Expected removals %v, got %v", want, removals)
+	}
+}
@@ -0,0 +1,857 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by clientgen from api-interfaces.go. DO NOT EDIT.
+
+package minio
+
+import (
+	"context"
+	"io"
+	"iter"
+	"net/url"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/cors"
+	"github.com/minio/minio-go/v7/pkg/lifecycle"
+	"github.com/minio/minio-go/v7/pkg/notification"
+	"github.com/minio/minio-go/v7/pkg/replication"
+	"github.com/minio/minio-go/v7/pkg/sse"
+	"github.com/minio/minio-go/v7/pkg/tags"
+)
+
+// MockClient is an API calling the function field named after each
+// method with a Func suffix, to substitute a Client in tests. Methods
+// whose function is nil return zero values and an APINotSupported
+// error, or panic if they return no error.
+type MockClient struct {
+	GetObjectFunc                        func(ctx context.Context, bucketName, objectName string, opts GetObjectOptions) (*Object, error)
+	GetObjectParallelFunc                func(ctx context.Context, bucketName, objectName string, w io.WriterAt, opts GetObjectOptions) (ObjectInfo, error)
+	FGetObjectFunc                       func(ctx context.Context, bucketName, objectName, filePath string, opts GetObjectOptions) error
+	StatObjectFunc                       func(ctx context.Context, bucketName, objectName string, opts StatObjectOptions) (ObjectInfo, error)
+	GetObjectACLFunc                     func(ctx context.Context, bucketName, objectName string) (*ObjectInfo, error)
+	GetObjectAttributesFunc              func(ctx context.Context, bucketName, objectName string, opts ObjectAttributesOptions) (*ObjectAttributes, error)
+	GetObjectTaggingFunc                 func(ctx context.Context, bucketName, objectName string, opts GetObjectTaggingOptions) (*tags.Tags, error)
+	GetObjectRetentionFunc               func(ctx context.Context, bucketName, objectName, versionID string) (*RetentionMode, *time.Time, error)
+	GetObjectLegalHoldFunc               func(ctx context.Context, bucketName, objectName string, opts GetObjectLegalHoldOptions) (*LegalHoldStatus, error)
+	SelectObjectContentFunc              func(ctx context.Context, bucketName, objectName string, opts SelectObjectOptions) (*SelectResults, error)
+	PromptObjectFunc                     func(ctx context.Context, bucketName, objectName, prompt string, opts PromptObjectOptions) (io.ReadCloser, error)
+	PresignedGetObjectFunc               func(ctx context.Context, bucketName, objectName string, expires time.Duration, reqParams url.Values) (*url.URL, error)
+	PresignedHeadObjectFunc              func(ctx context.Context, bucketName, objectName string, expires time.Duration, reqParams url.Values) (*url.URL, error)
+	PutObjectFunc                        func(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, opts PutObjectOptions) (UploadInfo, error)
+	FPutObjectFunc                       func(ctx context.Context, bucketName, objectName, filePath string, opts PutObjectOptions) (UploadInfo, error)
+	AppendObjectFunc                     func(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, opts AppendObjectOptions) (UploadInfo, error)
+	NewObjectWriterFunc                  func(ctx context.Context, bucketName, objectName string, opts PutObjectOptions) (*ObjectWriter, error)
+	PutObjectFanOutFunc                  func(ctx context.Context, bucket string, fanOutData io.Reader, fanOutReq PutObjectFanOutRequest) ([]PutObjectFanOutResponse, error)
+	PutObjectsSnowballFunc               func(ctx context.Context, bucketName string, opts SnowballOptions, objs <-chan SnowballObject) error
+	CopyObjectFunc                       func(ctx context.Context, dst CopyDestOptions, src CopySrcOptions) (UploadInfo, error)
+	ComposeObjectFunc                    func(ctx context.Context, dst CopyDestOptions, srcs ...CopySrcOptions) (UploadInfo, error)
+	CopyPrefixFunc                       func(ctx context.Context, srcBucket, srcPrefix, dstBucket, dstPrefix string, opts CopyPrefixOptions) (iter.Seq[CopyPrefixResult], error)
+	MovePrefixFunc                       func(ctx context.Context, srcBucket, srcPrefix, dstBucket, dstPrefix string, opts CopyPrefixOptions) (iter.Seq[CopyPrefixResult], error)
+	MirrorFunc                           func(ctx context.Context, bucketName, prefix, dir string, opts MirrorOptions) (iter.Seq[MirrorResult], error)
+	RemoveObjectFunc                     func(ctx context.Context, bucketName, objectName string, opts RemoveObjectOptions) error
+	RemoveObjectsFunc                    func(ctx context.Context, bucketName string, objectsCh <-chan ObjectInfo, opts RemoveObjectsOptions) <-chan RemoveObjectError
+	RemoveObjectsWithResultFunc          func(ctx context.Context, bucketName string, objectsCh <-chan ObjectInfo, opts RemoveObjectsOptions) <-chan RemoveObjectResult
+	RemoveObjectsWithIterFunc            func(ctx context.Context, bucketName string, objectsIter iter.Seq[ObjectInfo], opts RemoveObjectsOptions) (iter.Seq[RemoveObjectResult], error)
+	RemoveIncompleteUploadFunc           func(ctx context.Context, bucketName, objectName string) error
+	PutObjectTaggingFunc                 func(ctx context.Context, bucketName, objectName string, otags *tags.Tags, opts PutObjectTaggingOptions) error
+	RemoveObjectTaggingFunc              func(ctx context.Context, bucketName, objectName string, opts RemoveObjectTaggingOptions) error
+	PutObjectRetentionFunc               func(ctx context.Context, bucketName, objectName string, opts PutObjectRetentionOptions) error
+	PutObjectLegalHoldFunc               func(ctx context.Context, bucketName, objectName string, opts PutObjectLegalHoldOptions) error
+	RestoreObjectFunc                    func(ctx context.Context, bucketName, objectName, versionID string, req RestoreRequest) error
+	PresignedPutObjectFunc               func(ctx context.Context, bucketName, objectName string, expires time.Duration) (*url.URL, error)
+	PresignedPostPolicyFunc              func(ctx context.Context, p *PostPolicy) (*url.URL, map[string]string, error)
+	ListBucketsFunc                      func(ctx context.Context) ([]BucketInfo, error)
+	ListDirectoryBucketsFunc             func(ctx context.Context) (iter.Seq2[BucketInfo, error], error)
+	ListObjectsFunc                      func(ctx context.Context, bucketName string, opts ListObjectsOptions) <-chan ObjectInfo
+	ListObjectsIterFunc                  func(ctx context.Context, bucketName string, opts ListObjectsOptions) iter.Seq[ObjectInfo]
+	ListIncompleteUploadsFunc            func(ctx context.Context, bucketName, objectPrefix string, recursive bool) <-chan ObjectMultipartInfo
+	MakeBucketFunc                       func(ctx context.Context, bucketName string, opts MakeBucketOptions) error
+	BucketExistsFunc                     func(ctx context.Context, bucketName string) (bool, error)
+	RemoveBucketFunc                     func(ctx context.Context, bucketName string) error
+	RemoveBucketWithOptionsFunc          func(ctx context.Context, bucketName string, opts RemoveBucketOptions) error
+	GetBucketLocationFunc                func(ctx context.Context, bucketName string) (string, error)
+	EnableVersioningFunc                 func(ctx context.Context, bucketName string) error
+	SuspendVersioningFunc                func(ctx context.Context, bucketName string) error
+	SetBucketVersioningFunc              func(ctx context.Context, bucketName string, config BucketVersioningConfiguration) error
+	GetBucketVersioningFunc              func(ctx context.Context, bucketName string) (BucketVersioningConfiguration, error)
+	SetBucketPolicyFunc                  func(ctx context.Context, bucketName, policy string) error
+	GetBucketPolicyFunc                  func(ctx context.Context, bucketName string) (string, error)
+	SetBucketLifecycleFunc               func(ctx context.Context, bucketName string, config *lifecycle.Configuration) error
+	GetBucketLifecycleFunc               func(ctx context.Context, bucketName string) (*lifecycle.Configuration, error)
+	GetBucketLifecycleWithInfoFunc       func(ctx context.Context, bucketName string) (*lifecycle.Configuration, time.Time, error)
+	SetBucketTaggingFunc                 func(ctx context.Context, bucketName string, tags *tags.Tags) error
+	GetBucketTaggingFunc                 func(ctx context.Context, bucketName string) (*tags.Tags, error)
+	RemoveBucketTaggingFunc              func(ctx context.Context, bucketName string) error
+	SetBucketEncryptionFunc              func(ctx context.Context, bucketName string, config *sse.Configuration) error
+	GetBucketEncryptionFunc              func(ctx context.Context, bucketName string) (*sse.Configuration, error)
+	RemoveBucketEncryptionFunc           func(ctx context.Context, bucketName string) error
+	SetBucketCorsFunc                    func(ctx context.Context, bucketName string, corsConfig *cors.Config) error
+	GetBucketCorsFunc                    func(ctx context.Context, bucketName string) (*cors.Config, error)
+	SetBucketObjectLockConfigFunc        func(ctx context.Context, bucketName string, mode *RetentionMode, validity *uint, unit *ValidityUnit) error
+	GetBucketObjectLockConfigFunc        func(ctx context.Context, bucketName string) (*RetentionMode, *uint, *ValidityUnit, error)
+	SetObjectLockConfigFunc              func(ctx context.Context, bucketName string, mode *RetentionMode, validity *uint, unit *ValidityUnit) error
+	GetObjectLockConfigFunc              func(ctx context.Context, bucketName string) (string, *RetentionMode, *uint, *ValidityUnit, error)
+	SetBucketReplicationFunc             func(ctx context.Context, bucketName string, cfg replication.Config) error
+	GetBucketReplicationFunc             func(ctx context.Context, bucketName string) (replication.Config, error)
+	RemoveBucketReplicationFunc          func(ctx context.Context, bucketName string) error
+	CheckBucketReplicationFunc           func(ctx context.Context, bucketName string) error
+	GetBucketReplicationMetricsFunc      func(ctx context.Context, bucketName string) (replication.Metrics, error)
+	GetBucketReplicationMetricsV2Func    func(ctx context.Context, bucketName string) (replication.MetricsV2, error)
+	ResetBucketReplicationFunc           func(ctx context.Context, bucketName string, olderThan time.Duration) (string, error)
+	ResetBucketReplicationOnTargetFunc   func(ctx context.Context, bucketName string, olderThan time.Duration, tgtArn string) (replication.ResyncTargetsInfo, error)
+	GetBucketReplicationResyncStatusFunc func(ctx context.Context, bucketName, arn string) (replication.ResyncTargetsInfo, error)
+	CancelBucketReplicationResyncFunc    func(ctx context.Context, bucketName string, tgtArn string) (string, error)
+	SetBucketNotificationFunc            func(ctx context.Context, bucketName string, config notification.Configuration) error
+	GetBucketNotificationFunc            func(ctx context.Context, bucketName string) (notification.Configuration, error)
+	RemoveAllBucketNotificationFunc      func(ctx context.Context, bucketName string) error
+	ListenBucketNotificationFunc         func(ctx context.Context, bucketName, prefix, suffix string, events []string) <-chan notification.Info
+	ListenNotificationFunc               func(ctx context.Context, prefix, suffix string, events []string) <-chan notification.Info
+}
+
+var _ API = (*MockClient)(nil)
+
+// GetObject calls m.GetObjectFunc.
+func (m *MockClient) GetObject(ctx context.Context, bucketName, objectName string, opts GetObjectOptions) (r0 *Object, err error) {
+	if m.GetObjectFunc == nil {
+		err = errAPINotSupported("MockClient.GetObject is not mocked")
+		return
+	}
+	return m.GetObjectFunc(ctx, bucketName, objectName, opts)
+}
+
+// GetObjectParallel calls m.GetObjectParallelFunc.
+func (m *MockClient) GetObjectParallel(ctx context.Context, bucketName, objectName string, w io.WriterAt, opts GetObjectOptions) (r0 ObjectInfo, err error) {
+	if m.GetObjectParallelFunc == nil {
+		err = errAPINotSupported("MockClient.GetObjectParallel is not mocked")
+		return
+	}
+	return m.GetObjectParallelFunc(ctx, bucketName, objectName, w, opts)
+}
+
+// FGetObject calls m.FGetObjectFunc.
+func (m *MockClient) FGetObject(ctx context.Context, bucketName, objectName, filePath string, opts GetObjectOptions) (err error) {
+	if m.FGetObjectFunc == nil {
+		err = errAPINotSupported("MockClient.FGetObject is not mocked")
+		return
+	}
+	return m.FGetObjectFunc(ctx, bucketName, objectName, filePath, opts)
+}
+
+// StatObject calls m.StatObjectFunc.
+func (m *MockClient) StatObject(ctx context.Context, bucketName, objectName string, opts StatObjectOptions) (r0 ObjectInfo, err error) {
+	if m.StatObjectFunc == nil {
+		err = errAPINotSupported("MockClient.StatObject is not mocked")
+		return
+	}
+	return m.StatObjectFunc(ctx, bucketName, objectName, opts)
+}
+
+// GetObjectACL calls m.GetObjectACLFunc.
+func (m *MockClient) GetObjectACL(ctx context.Context, bucketName, objectName string) (r0 *ObjectInfo, err error) {
+	if m.GetObjectACLFunc == nil {
+		err = errAPINotSupported("MockClient.GetObjectACL is not mocked")
+		return
+	}
+	return m.GetObjectACLFunc(ctx, bucketName, objectName)
+}
+
+// GetObjectAttributes calls m.GetObjectAttributesFunc.
+func (m *MockClient) GetObjectAttributes(ctx context.Context, bucketName, objectName string, opts ObjectAttributesOptions) (r0 *ObjectAttributes, err error) {
+	if m.GetObjectAttributesFunc == nil {
+		err = errAPINotSupported("MockClient.GetObjectAttributes is not mocked")
+		return
+	}
+	return m.GetObjectAttributesFunc(ctx, bucketName, objectName, opts)
+}
+
+// GetObjectTagging calls m.GetObjectTaggingFunc.
+func (m *MockClient) GetObjectTagging(ctx context.Context, bucketName, objectName string, opts GetObjectTaggingOptions) (r0 *tags.Tags, err error) {
+	if m.GetObjectTaggingFunc == nil {
+		err = errAPINotSupported("MockClient.GetObjectTagging is not mocked")
+		return
+	}
+	return m.GetObjectTaggingFunc(ctx, bucketName, objectName, opts)
+}
+
+// GetObjectRetention calls m.GetObjectRetentionFunc.
+func (m *MockClient) GetObjectRetention(ctx context.Context, bucketName, objectName, versionID string) (r0 *RetentionMode, r1 *time.Time, err error) {
+	if m.GetObjectRetentionFunc == nil {
+		err = errAPINotSupported("MockClient.GetObjectRetention is not mocked")
+		return
+	}
+	return m.GetObjectRetentionFunc(ctx, bucketName, objectName, versionID)
+}
+
+// GetObjectLegalHold calls m.GetObjectLegalHoldFunc.
+func (m *MockClient) GetObjectLegalHold(ctx context.Context, bucketName, objectName string, opts GetObjectLegalHoldOptions) (r0 *LegalHoldStatus, err error) {
+	if m.GetObjectLegalHoldFunc == nil {
+		err = errAPINotSupported("MockClient.GetObjectLegalHold is not mocked")
+		return
+	}
+	return m.GetObjectLegalHoldFunc(ctx, bucketName, objectName, opts)
+}
+
+// SelectObjectContent calls m.SelectObjectContentFunc.
+func (m *MockClient) SelectObjectContent(ctx context.Context, bucketName, objectName string, opts SelectObjectOptions) (r0 *SelectResults, err error) {
+	if m.SelectObjectContentFunc == nil {
+		err = errAPINotSupported("MockClient.SelectObjectContent is not mocked")
+		return
+	}
+	return m.SelectObjectContentFunc(ctx, bucketName, objectName, opts)
+}
+
+// PromptObject calls m.PromptObjectFunc.
+func (m *MockClient) PromptObject(ctx context.Context, bucketName, objectName, prompt string, opts PromptObjectOptions) (r0 io.ReadCloser, err error) {
+	if m.PromptObjectFunc == nil {
+		err = errAPINotSupported("MockClient.PromptObject is not mocked")
+		return
+	}
+	return m.PromptObjectFunc(ctx, bucketName, objectName, prompt, opts)
+}
+
+// PresignedGetObject calls m.PresignedGetObjectFunc.
+func (m *MockClient) PresignedGetObject(ctx context.Context, bucketName, objectName string, expires time.Duration, reqParams url.Values) (r0 *url.URL, err error) {
+	if m.PresignedGetObjectFunc == nil {
+		err = errAPINotSupported("MockClient.PresignedGetObject is not mocked")
+		return
+	}
+	return m.PresignedGetObjectFunc(ctx, bucketName, objectName, expires, reqParams)
+}
+
+// PresignedHeadObject calls m.PresignedHeadObjectFunc.
+func (m *MockClient) PresignedHeadObject(ctx context.Context, bucketName, objectName string, expires time.Duration, reqParams url.Values) (r0 *url.URL, err error) {
+	if m.PresignedHeadObjectFunc == nil {
+		err = errAPINotSupported("MockClient.PresignedHeadObject is not mocked")
+		return
+	}
+	return m.PresignedHeadObjectFunc(ctx, bucketName, objectName, expires, reqParams)
+}
+
+// PutObject calls m.PutObjectFunc.
+func (m *MockClient) PutObject(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, opts PutObjectOptions) (r0 UploadInfo, err error) {
+	if m.PutObjectFunc == nil {
+		err = errAPINotSupported("MockClient.PutObject is not mocked")
+		return
+	}
+	return m.PutObjectFunc(ctx, bucketName, objectName, reader, objectSize, opts)
+}
+
+// FPutObject calls m.FPutObjectFunc.
+func (m *MockClient) FPutObject(ctx context.Context, bucketName, objectName, filePath string, opts PutObjectOptions) (r0 UploadInfo, err error) {
+	if m.FPutObjectFunc == nil {
+		err = errAPINotSupported("MockClient.FPutObject is not mocked")
+		return
+	}
+	return m.FPutObjectFunc(ctx, bucketName, objectName, filePath, opts)
+}
+
+// AppendObject calls m.AppendObjectFunc.
+func (m *MockClient) AppendObject(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, opts AppendObjectOptions) (r0 UploadInfo, err error) {
+	if m.AppendObjectFunc == nil {
+		err = errAPINotSupported("MockClient.AppendObject is not mocked")
+		return
+	}
+	return m.AppendObjectFunc(ctx, bucketName, objectName, reader, objectSize, opts)
+}
+
+// NewObjectWriter calls m.NewObjectWriterFunc.
+func (m *MockClient) NewObjectWriter(ctx context.Context, bucketName, objectName string, opts PutObjectOptions) (r0 *ObjectWriter, err error) {
+	if m.NewObjectWriterFunc == nil {
+		err = errAPINotSupported("MockClient.NewObjectWriter is not mocked")
+		return
+	}
+	return m.NewObjectWriterFunc(ctx, bucketName, objectName, opts)
+}
+
+// PutObjectFanOut calls m.PutObjectFanOutFunc.
+func (m *MockClient) PutObjectFanOut(ctx context.Context, bucket string, fanOutData io.Reader, fanOutReq PutObjectFanOutRequest) (r0 []PutObjectFanOutResponse, err error) {
+	if m.PutObjectFanOutFunc == nil {
+		err = errAPINotSupported("MockClient.PutObjectFanOut is not mocked")
+		return
+	}
+	return m.PutObjectFanOutFunc(ctx, bucket, fanOutData, fanOutReq)
+}
+
+// PutObjectsSnowball calls m.PutObjectsSnowballFunc.
+func (m *MockClient) PutObjectsSnowball(ctx context.Context, bucketName string, opts SnowballOptions, objs <-chan SnowballObject) (err error) {
+	if m.PutObjectsSnowballFunc == nil {
+		err = errAPINotSupported("MockClient.PutObjectsSnowball is not mocked")
+		return
+	}
+	return m.PutObjectsSnowballFunc(ctx, bucketName, opts, objs)
+}
+
+// CopyObject calls m.CopyObjectFunc.
+func (m *MockClient) CopyObject(ctx context.Context, dst CopyDestOptions, src CopySrcOptions) (r0 UploadInfo, err error) {
+	if m.CopyObjectFunc == nil {
+		err = errAPINotSupported("MockClient.CopyObject is not mocked")
+		return
+	}
+	return m.CopyObjectFunc(ctx, dst, src)
+}
+
+// ComposeObject calls m.ComposeObjectFunc.
+func (m *MockClient) ComposeObject(ctx context.Context, dst CopyDestOptions, srcs ...CopySrcOptions) (r0 UploadInfo, err error) {
+	if m.ComposeObjectFunc == nil {
+		err = errAPINotSupported("MockClient.ComposeObject is not mocked")
+		return
+	}
+	return m.ComposeObjectFunc(ctx, dst, srcs...)
+}
+
+// CopyPrefix calls m.CopyPrefixFunc.
+func (m *MockClient) CopyPrefix(ctx context.Context, srcBucket, srcPrefix, dstBucket, dstPrefix string, opts CopyPrefixOptions) (r0 iter.Seq[CopyPrefixResult], err error) {
+	if m.CopyPrefixFunc == nil {
+		err = errAPINotSupported("MockClient.CopyPrefix is not mocked")
+		return
+	}
+	return m.CopyPrefixFunc(ctx, srcBucket, srcPrefix, dstBucket, dstPrefix, opts)
+}
+
+// MovePrefix calls m.MovePrefixFunc.
+func (m *MockClient) MovePrefix(ctx context.Context, srcBucket, srcPrefix, dstBucket, dstPrefix string, opts CopyPrefixOptions) (r0 iter.Seq[CopyPrefixResult], err error) {
+	if m.MovePrefixFunc == nil {
+		err = errAPINotSupported("MockClient.MovePrefix is not mocked")
+		return
+	}
+	return m.MovePrefixFunc(ctx, srcBucket, srcPrefix, dstBucket, dstPrefix, opts)
+}
+
+// Mirror calls m.MirrorFunc.
+func (m *MockClient) Mirror(ctx context.Context, bucketName, prefix, dir string, opts MirrorOptions) (r0 iter.Seq[MirrorResult], err error) {
+	if m.MirrorFunc == nil {
+		err = errAPINotSupported("MockClient.Mirror is not mocked")
+		return
+	}
+	return m.MirrorFunc(ctx, bucketName, prefix, dir, opts)
+}
+
+// RemoveObject calls m.RemoveObjectFunc.
+func (m *MockClient) RemoveObject(ctx context.Context, bucketName, objectName string, opts RemoveObjectOptions) (err error) {
+	if m.RemoveObjectFunc == nil {
+		err = errAPINotSupported("MockClient.RemoveObject is not mocked")
+		return
+	}
+	return m.RemoveObjectFunc(ctx, bucketName, objectName, opts)
+}
+
+// RemoveObjects calls m.RemoveObjectsFunc.
+func (m *MockClient) RemoveObjects(ctx context.Context, bucketName string, objectsCh <-chan ObjectInfo, opts RemoveObjectsOptions) <-chan RemoveObjectError {
+	if m.RemoveObjectsFunc == nil {
+		panic("minio: MockClient.RemoveObjects is not mocked")
+	}
+	return m.RemoveObjectsFunc(ctx, bucketName, objectsCh, opts)
+}
+
+// RemoveObjectsWithResult calls m.RemoveObjectsWithResultFunc.
+func (m *MockClient) RemoveObjectsWithResult(ctx context.Context, bucketName string, objectsCh <-chan ObjectInfo, opts RemoveObjectsOptions) <-chan RemoveObjectResult {
+	if m.RemoveObjectsWithResultFunc == nil {
+		panic("minio: MockClient.RemoveObjectsWithResult is not mocked")
+	}
+	return m.RemoveObjectsWithResultFunc(ctx, bucketName, objectsCh, opts)
+}
+
+// RemoveObjectsWithIter calls m.RemoveObjectsWithIterFunc.
+func (m *MockClient) RemoveObjectsWithIter(ctx context.Context, bucketName string, objectsIter iter.Seq[ObjectInfo], opts RemoveObjectsOptions) (r0 iter.Seq[RemoveObjectResult], err error) {
+	if m.RemoveObjectsWithIterFunc == nil {
+		err = errAPINotSupported("MockClient.RemoveObjectsWithIter is not mocked")
+		return
+	}
+	return m.RemoveObjectsWithIterFunc(ctx, bucketName, objectsIter, opts)
+}
+
+// RemoveIncompleteUpload calls m.RemoveIncompleteUploadFunc.
+func (m *MockClient) RemoveIncompleteUpload(ctx context.Context, bucketName, objectName string) (err error) {
+	if m.RemoveIncompleteUploadFunc == nil {
+		err = errAPINotSupported("MockClient.RemoveIncompleteUpload is not mocked")
+		return
+	}
+	return m.RemoveIncompleteUploadFunc(ctx, bucketName, objectName)
+}
+
+// PutObjectTagging calls m.PutObjectTaggingFunc.
+func (m *MockClient) PutObjectTagging(ctx context.Context, bucketName, objectName string, otags *tags.Tags, opts PutObjectTaggingOptions) (err error) {
+	if m.PutObjectTaggingFunc == nil {
+		err = errAPINotSupported("MockClient.PutObjectTagging is not mocked")
+		return
+	}
+	return m.PutObjectTaggingFunc(ctx, bucketName, objectName, otags, opts)
+}
+
+// RemoveObjectTagging calls m.RemoveObjectTaggingFunc.
+func (m *MockClient) RemoveObjectTagging(ctx context.Context, bucketName, objectName string, opts RemoveObjectTaggingOptions) (err error) {
+	if m.RemoveObjectTaggingFunc == nil {
+		err = errAPINotSupported("MockClient.RemoveObjectTagging is not mocked")
+		return
+	}
+	return m.RemoveObjectTaggingFunc(ctx, bucketName, objectName, opts)
+}
+
+// PutObjectRetention calls m.PutObjectRetentionFunc.
+func (m *MockClient) PutObjectRetention(ctx context.Context, bucketName, objectName string, opts PutObjectRetentionOptions) (err error) {
+	if m.PutObjectRetentionFunc == nil {
+		err = errAPINotSupported("MockClient.PutObjectRetention is not mocked")
+		return
+	}
+	return m.PutObjectRetentionFunc(ctx, bucketName, objectName, opts)
+}
+
+// PutObjectLegalHold calls m.PutObjectLegalHoldFunc.
+func (m *MockClient) PutObjectLegalHold(ctx context.Context, bucketName, objectName string, opts PutObjectLegalHoldOptions) (err error) {
+	if m.PutObjectLegalHoldFunc == nil {
+		err = errAPINotSupported("MockClient.PutObjectLegalHold is not mocked")
+		return
+	}
+	return m.PutObjectLegalHoldFunc(ctx, bucketName, objectName, opts)
+}
+
+// RestoreObject calls m.RestoreObjectFunc.
+func (m *MockClient) RestoreObject(ctx context.Context, bucketName, objectName, versionID string, req RestoreRequest) (err error) {
+	if m.RestoreObjectFunc == nil {
+		err = errAPINotSupported("MockClient.RestoreObject is not mocked")
+		return
+	}
+	return m.RestoreObjectFunc(ctx, bucketName, objectName, versionID, req)
+}
+
+// PresignedPutObject calls m.PresignedPutObjectFunc.
+func (m *MockClient) PresignedPutObject(ctx context.Context, bucketName, objectName string, expires time.Duration) (r0 *url.URL, err error) {
+	if m.PresignedPutObjectFunc == nil {
+		err = errAPINotSupported("MockClient.PresignedPutObject is not mocked")
+		return
+	}
+	return m.PresignedPutObjectFunc(ctx, bucketName, objectName, expires)
+}
+
+// PresignedPostPolicy calls m.PresignedPostPolicyFunc.
+func (m *MockClient) PresignedPostPolicy(ctx context.Context, p *PostPolicy) (r0 *url.URL, r1 map[string]string, err error) {
+	if m.PresignedPostPolicyFunc == nil {
+		err = errAPINotSupported("MockClient.PresignedPostPolicy is not mocked")
+		return
+	}
+	return m.PresignedPostPolicyFunc(ctx, p)
+}
+
+// ListBuckets calls m.ListBucketsFunc.
+func (m *MockClient) ListBuckets(ctx context.Context) (r0 []BucketInfo, err error) {
+	if m.ListBucketsFunc == nil {
+		err = errAPINotSupported("MockClient.ListBuckets is not mocked")
+		return
+	}
+	return m.ListBucketsFunc(ctx)
+}
+
+// ListDirectoryBuckets calls m.ListDirectoryBucketsFunc.
+func (m *MockClient) ListDirectoryBuckets(ctx context.Context) (r0 iter.Seq2[BucketInfo, error], err error) {
+	if m.ListDirectoryBucketsFunc == nil {
+		err = errAPINotSupported("MockClient.ListDirectoryBuckets is not mocked")
+		return
+	}
+	return m.ListDirectoryBucketsFunc(ctx)
+}
+
+// ListObjects calls m.ListObjectsFunc.
+func (m *MockClient) ListObjects(ctx context.Context, bucketName string, opts ListObjectsOptions) <-chan ObjectInfo {
+	if m.ListObjectsFunc == nil {
+		panic("minio: MockClient.ListObjects is not mocked")
+	}
+	return m.ListObjectsFunc(ctx, bucketName, opts)
+}
+
+// ListObjectsIter calls m.ListObjectsIterFunc.
+func (m *MockClient) ListObjectsIter(ctx context.Context, bucketName string, opts ListObjectsOptions) iter.Seq[ObjectInfo] {
+	if m.ListObjectsIterFunc == nil {
+		panic("minio: MockClient.ListObjectsIter is not mocked")
+	}
+	return m.ListObjectsIterFunc(ctx, bucketName, opts)
+}
+
+// ListIncompleteUploads calls m.ListIncompleteUploadsFunc.
+func (m *MockClient) ListIncompleteUploads(ctx context.Context, bucketName, objectPrefix string, recursive bool) <-chan ObjectMultipartInfo {
+	if m.ListIncompleteUploadsFunc == nil {
+		panic("minio: MockClient.ListIncompleteUploads is not mocked")
+	}
+	return m.ListIncompleteUploadsFunc(ctx, bucketName, objectPrefix, recursive)
+}
+
+// MakeBucket calls m.MakeBucketFunc.
+func (m *MockClient) MakeBucket(ctx context.Context, bucketName string, opts MakeBucketOptions) (err error) {
+	if m.MakeBucketFunc == nil {
+		err = errAPINotSupported("MockClient.MakeBucket is not mocked")
+		return
+	}
+	return m.MakeBucketFunc(ctx, bucketName, opts)
+}
+
+// BucketExists calls m.BucketExistsFunc.
+func (m *MockClient) BucketExists(ctx context.Context, bucketName string) (r0 bool, err error) {
+	if m.BucketExistsFunc == nil {
+		err = errAPINotSupported("MockClient.BucketExists is not mocked")
+		return
+	}
+	return m.BucketExistsFunc(ctx, bucketName)
+}
+
+// RemoveBucket calls m.RemoveBucketFunc.
+func (m *MockClient) RemoveBucket(ctx context.Context, bucketName string) (err error) {
+	if m.RemoveBucketFunc == nil {
+		err = errAPINotSupported("MockClient.RemoveBucket is not mocked")
+		return
+	}
+	return m.RemoveBucketFunc(ctx, bucketName)
+}
+
+// RemoveBucketWithOptions calls m.RemoveBucketWithOptionsFunc.
+func (m *MockClient) RemoveBucketWithOptions(ctx context.Context, bucketName string, opts RemoveBucketOptions) (err error) {
+	if m.RemoveBucketWithOptionsFunc == nil {
+		err = errAPINotSupported("MockClient.RemoveBucketWithOptions is not mocked")
+		return
+	}
+	return m.RemoveBucketWithOptionsFunc(ctx, bucketName, opts)
+}
+
+// GetBucketLocation calls m.GetBucketLocationFunc.
+func (m *MockClient) GetBucketLocation(ctx context.Context, bucketName string) (r0 string, err error) {
+	if m.GetBucketLocationFunc == nil {
+		err = errAPINotSupported("MockClient.GetBucketLocation is not mocked")
+		return
+	}
+	return m.GetBucketLocationFunc(ctx, bucketName)
+}
+
+// EnableVersioning calls m.EnableVersioningFunc.
+func (m *MockClient) EnableVersioning(ctx context.Context, bucketName string) (err error) {
+	if m.EnableVersioningFunc == nil {
+		err = errAPINotSupported("MockClient.EnableVersioning is not mocked")
+		return
+	}
+	return m.EnableVersioningFunc(ctx, bucketName)
+}
+
+// SuspendVersioning calls m.SuspendVersioningFunc.
+func (m *MockClient) SuspendVersioning(ctx context.Context, bucketName string) (err error) {
+	if m.SuspendVersioningFunc == nil {
+		err = errAPINotSupported("MockClient.SuspendVersioning is not mocked")
+		return
+	}
+	return m.SuspendVersioningFunc(ctx, bucketName)
+}
+
+// SetBucketVersioning calls m.SetBucketVersioningFunc.
+func (m *MockClient) SetBucketVersioning(ctx context.Context, bucketName string, config BucketVersioningConfiguration) (err error) {
+	if m.SetBucketVersioningFunc == nil {
+		err = errAPINotSupported("MockClient.SetBucketVersioning is not mocked")
+		return
+	}
+	return m.SetBucketVersioningFunc(ctx, bucketName, config)
+}
+
+// GetBucketVersioning calls m.GetBucketVersioningFunc.
+func (m *MockClient) GetBucketVersioning(ctx context.Context, bucketName string) (r0 BucketVersioningConfiguration, err error) {
+	if m.GetBucketVersioningFunc == nil {
+		err = errAPINotSupported("MockClient.GetBucketVersioning is not mocked")
+		return
+	}
+	return m.GetBucketVersioningFunc(ctx, bucketName)
+}
+
+// SetBucketPolicy calls m.SetBucketPolicyFunc.
+func (m *MockClient) SetBucketPolicy(ctx context.Context, bucketName, policy string) (err error) {
+	if m.SetBucketPolicyFunc == nil {
+		err = errAPINotSupported("MockClient.SetBucketPolicy is not mocked")
+		return
+	}
+	return m.SetBucketPolicyFunc(ctx, bucketName, policy)
+}
+
+// GetBucketPolicy calls m.GetBucketPolicyFunc.
+func (m *MockClient) GetBucketPolicy(ctx context.Context, bucketName string) (r0 string, err error) {
+	if m.GetBucketPolicyFunc == nil {
+		err = errAPINotSupported("MockClient.GetBucketPolicy is not mocked")
+		return
+	}
+	return m.GetBucketPolicyFunc(ctx, bucketName)
+}
+
+// SetBucketLifecycle calls m.SetBucketLifecycleFunc.
+func (m *MockClient) SetBucketLifecycle(ctx context.Context, bucketName string, config *lifecycle.Configuration) (err error) {
+	if m.SetBucketLifecycleFunc == nil {
+		err = errAPINotSupported("MockClient.SetBucketLifecycle is not mocked")
+		return
+	}
+	return m.SetBucketLifecycleFunc(ctx, bucketName, config)
+}
+
+// GetBucketLifecycle calls m.GetBucketLifecycleFunc.
+func (m *MockClient) GetBucketLifecycle(ctx context.Context, bucketName string) (r0 *lifecycle.Configuration, err error) {
+	if m.GetBucketLifecycleFunc == nil {
+		err = errAPINotSupported("MockClient.GetBucketLifecycle is not mocked")
+		return
+	}
+	return m.GetBucketLifecycleFunc(ctx, bucketName)
+}
+
+// GetBucketLifecycleWithInfo calls m.GetBucketLifecycleWithInfoFunc.
+func (m *MockClient) GetBucketLifecycleWithInfo(ctx context.Context, bucketName string) (r0 *lifecycle.Configuration, r1 time.Time, err error) {
+	if m.GetBucketLifecycleWithInfoFunc == nil {
+		err = errAPINotSupported("MockClient.GetBucketLifecycleWithInfo is not mocked")
+		return
+	}
+	return m.GetBucketLifecycleWithInfoFunc(ctx, bucketName)
+}
+
+// SetBucketTagging calls m.SetBucketTaggingFunc.
+func (m *MockClient) SetBucketTagging(ctx context.Context, bucketName string, tags *tags.Tags) (err error) {
+	if m.SetBucketTaggingFunc == nil {
+		err = errAPINotSupported("MockClient.SetBucketTagging is not mocked")
+		return
+	}
+	return m.SetBucketTaggingFunc(ctx, bucketName, tags)
+}
+
+// GetBucketTagging calls m.GetBucketTaggingFunc.
+func (m *MockClient) GetBucketTagging(ctx context.Context, bucketName string) (r0 *tags.Tags, err error) {
+	if m.GetBucketTaggingFunc == nil {
+		err = errAPINotSupported("MockClient.GetBucketTagging is not mocked")
+		return
+	}
+	return m.GetBucketTaggingFunc(ctx, bucketName)
+}
+
+// RemoveBucketTagging calls m.RemoveBucketTaggingFunc.
+func (m *MockClient) RemoveBucketTagging(ctx context.Context, bucketName string) (err error) {
+	if m.RemoveBucketTaggingFunc == nil {
+		err = errAPINotSupported("MockClient.RemoveBucketTagging is not mocked")
+		return
+	}
+	return m.RemoveBucketTaggingFunc(ctx, bucketName)
+}
+
+// SetBucketEncryption calls m.SetBucketEncryptionFunc.
+func (m *MockClient) SetBucketEncryption(ctx context.Context, bucketName string, config *sse.Configuration) (err error) {
+	if m.SetBucketEncryptionFunc == nil {
+		err = errAPINotSupported("MockClient.SetBucketEncryption is not mocked")
+		return
+	}
+	return m.SetBucketEncryptionFunc(ctx, bucketName, config)
+}
+
+// GetBucketEncryption calls m.GetBucketEncryptionFunc.
+func (m *MockClient) GetBucketEncryption(ctx context.Context, bucketName string) (r0 *sse.Configuration, err error) {
+	if m.GetBucketEncryptionFunc == nil {
+		err = errAPINotSupported("MockClient.GetBucketEncryption is not mocked")
+		return
+	}
+	return m.GetBucketEncryptionFunc(ctx, bucketName)
+}
+
+// RemoveBucketEncryption calls m.RemoveBucketEncryptionFunc.
+func (m *MockClient) RemoveBucketEncryption(ctx context.Context, bucketName string) (err error) {
+	if m.RemoveBucketEncryptionFunc == nil {
+		err = errAPINotSupported("MockClient.RemoveBucketEncryption is not mocked")
+		return
+	}
+	return m.RemoveBucketEncryptionFunc(ctx, bucketName)
+}
+
+// SetBucketCors calls m.SetBucketCorsFunc.
+func (m *MockClient) SetBucketCors(ctx context.Context, bucketName string, corsConfig *cors.Config) (err error) {
+	if m.SetBucketCorsFunc == nil {
+		err = errAPINotSupported("MockClient.SetBucketCors is not mocked")
+		return
+	}
+	return m.SetBucketCorsFunc(ctx, bucketName, corsConfig)
+}
+
+// GetBucketCors calls m.GetBucketCorsFunc.
+func (m *MockClient) GetBucketCors(ctx context.Context, bucketName string) (r0 *cors.Config, err error) {
+	if m.GetBucketCorsFunc == nil {
+		err = errAPINotSupported("MockClient.GetBucketCors is not mocked")
+		return
+	}
+	return m.GetBucketCorsFunc(ctx, bucketName)
+}
+
+// SetBucketObjectLockConfig calls m.SetBucketObjectLockConfigFunc.
+func (m *MockClient) SetBucketObjectLockConfig(ctx context.Context, bucketName string, mode *RetentionMode, validity *uint, unit *ValidityUnit) (err error) {
+	if m.SetBucketObjectLockConfigFunc == nil {
+		err = errAPINotSupported("MockClient.SetBucketObjectLockConfig is not mocked")
+		return
+	}
+	return m.SetBucketObjectLockConfigFunc(ctx, bucketName, mode, validity, unit)
+}
+
+// GetBucketObjectLockConfig calls m.GetBucketObjectLockConfigFunc.
+func (m *MockClient) GetBucketObjectLockConfig(ctx context.Context, bucketName string) (r0 *RetentionMode, r1 *uint, r2 *ValidityUnit, err error) {
+	if m.GetBucketObjectLockConfigFunc == nil {
+		err = errAPINotSupported("MockClient.GetBucketObjectLockConfig is not mocked")
+		return
+	}
+	return m.GetBucketObjectLockConfigFunc(ctx, bucketName)
+}
+
+// SetObjectLockConfig calls m.SetObjectLockConfigFunc.
+func (m *MockClient) SetObjectLockConfig(ctx context.Context, bucketName string, mode *RetentionMode, validity *uint, unit *ValidityUnit) (err error) {
+	if m.SetObjectLockConfigFunc == nil {
+		err = errAPINotSupported("MockClient.SetObjectLockConfig is not mocked")
+		return
+	}
+	return m.SetObjectLockConfigFunc(ctx, bucketName, mode, validity, unit)
+}
+
+// GetObjectLockConfig calls m.GetObjectLockConfigFunc.
+func (m *MockClient) GetObjectLockConfig(ctx context.Context, bucketName string) (r0 string, r1 *RetentionMode, r2 *uint, r3 *ValidityUnit, err error) {
+	if m.GetObjectLockConfigFunc == nil {
+		err = errAPINotSupported("MockClient.GetObjectLockConfig is not mocked")
+		return
+	}
+	return m.GetObjectLockConfigFunc(ctx, bucketName)
+}
+
+// SetBucketReplication calls m.SetBucketReplicationFunc.
+func (m *MockClient) SetBucketReplication(ctx context.Context, bucketName string, cfg replication.Config) (err error) {
+	if m.SetBucketReplicationFunc == nil {
+		err = errAPINotSupported("MockClient.SetBucketReplication is not mocked")
+		return
+	}
+	return m.SetBucketReplicationFunc(ctx, bucketName, cfg)
+}
+
+// GetBucketReplication calls m.GetBucketReplicationFunc.
+func (m *MockClient) GetBucketReplication(ctx context.Context, bucketName string) (r0 replication.Config, err error) {
+	if m.GetBucketReplicationFunc == nil {
+		err = errAPINotSupported("MockClient.GetBucketReplication is not mocked")
+		return
+	}
+	return m.GetBucketReplicationFunc(ctx, bucketName)
+}
+
+// RemoveBucketReplication calls m.RemoveBucketReplicationFunc.
+func (m *MockClient) RemoveBucketReplication(ctx context.Context, bucketName string) (err error) {
+	if m.RemoveBucketReplicationFunc == nil {
+		err = errAPINotSupported("MockClient.RemoveBucketReplication is not mocked")
+		return
+	}
+	return m.RemoveBucketReplicationFunc(ctx, bucketName)
+}
+
+// CheckBucketReplication calls m.CheckBucketReplicationFunc.
+func (m *MockClient) CheckBucketReplication(ctx context.Context, bucketName string) (err error) {
+	if m.CheckBucketReplicationFunc == nil {
+		err = errAPINotSupported("MockClient.CheckBucketReplication is not mocked")
+		return
+	}
+	return m.CheckBucketReplicationFunc(ctx, bucketName)
+}
+
+// GetBucketReplicationMetrics calls m.GetBucketReplicationMetricsFunc.
+func (m *MockClient) GetBucketReplicationMetrics(ctx context.Context, bucketName string) (r0 replication.Metrics, err error) {
+	if m.GetBucketReplicationMetricsFunc == nil {
+		err = errAPINotSupported("MockClient.GetBucketReplicationMetrics is not mocked")
+		return
+	}
+	return m.GetBucketReplicationMetricsFunc(ctx, bucketName)
+}
+
+// GetBucketReplicationMetricsV2 calls m.GetBucketReplicationMetricsV2Func.
+func (m *MockClient) GetBucketReplicationMetricsV2(ctx context.Context, bucketName string) (r0 replication.MetricsV2, err error) {
+	if m.GetBucketReplicationMetricsV2Func == nil {
+		err = errAPINotSupported("MockClient.GetBucketReplicationMetricsV2 is not mocked")
+		return
+	}
+	return m.GetBucketReplicationMetricsV2Func(ctx, bucketName)
+}
+
+// ResetBucketReplication calls m.ResetBucketReplicationFunc.
+func (m *MockClient) ResetBucketReplication(ctx context.Context, bucketName string, olderThan time.Duration) (r0 string, err error) {
+	if m.ResetBucketReplicationFunc == nil {
+		err = errAPINotSupported("MockClient.ResetBucketReplication is not mocked")
+		return
+	}
+	return m.ResetBucketReplicationFunc(ctx, bucketName, olderThan)
+}
+
+// ResetBucketReplicationOnTarget calls m.ResetBucketReplicationOnTargetFunc.
+func (m *MockClient) ResetBucketReplicationOnTarget(ctx context.Context, bucketName string, olderThan time.Duration, tgtArn string) (r0 replication.ResyncTargetsInfo, err error) {
+	if m.ResetBucketReplicationOnTargetFunc == nil {
+		err = errAPINotSupported("MockClient.ResetBucketReplicationOnTarget is not mocked")
+		return
+	}
+	return m.ResetBucketReplicationOnTargetFunc(ctx, bucketName, olderThan, tgtArn)
+}
+
+// GetBucketReplicationResyncStatus calls m.GetBucketReplicationResyncStatusFunc.
+func (m *MockClient) GetBucketReplicationResyncStatus(ctx context.Context, bucketName, arn string) (r0 replication.ResyncTargetsInfo, err error) {
+	if m.GetBucketReplicationResyncStatusFunc == nil {
+		err = errAPINotSupported("MockClient.GetBucketReplicationResyncStatus is not mocked")
+		return
+	}
+	return m.GetBucketReplicationResyncStatusFunc(ctx, bucketName, arn)
+}
+
+// CancelBucketReplicationResync calls m.CancelBucketReplicationResyncFunc.
+func (m *MockClient) CancelBucketReplicationResync(ctx context.Context, bucketName string, tgtArn string) (r0 string, err error) {
+	if m.CancelBucketReplicationResyncFunc == nil {
+		err = errAPINotSupported("MockClient.CancelBucketReplicationResync is not mocked")
+		return
+	}
+	return m.CancelBucketReplicationResyncFunc(ctx, bucketName, tgtArn)
+}
+
+// SetBucketNotification calls m.SetBucketNotificationFunc.
+func (m *MockClient) SetBucketNotification(ctx context.Context, bucketName string, config notification.Configuration) (err error) {
+	if m.SetBucketNotificationFunc == nil {
+		err = errAPINotSupported("MockClient.SetBucketNotification is not mocked")
+		return
+	}
+	return m.SetBucketNotificationFunc(ctx, bucketName, config)
+}
+
+// GetBucketNotification calls m.GetBucketNotificationFunc.
+func (m *MockClient) GetBucketNotification(ctx context.Context, bucketName string) (r0 notification.Configuration, err error) {
+	if m.GetBucketNotificationFunc == nil {
+		err = errAPINotSupported("MockClient.GetBucketNotification is not mocked")
+		return
+	}
+	return m.GetBucketNotificationFunc(ctx, bucketName)
+}
+
+// RemoveAllBucketNotification calls m.RemoveAllBucketNotificationFunc.
+func (m *MockClient) RemoveAllBucketNotification(ctx context.Context, bucketName string) (err error) {
+	if m.RemoveAllBucketNotificationFunc == nil {
+		err = errAPINotSupported("MockClient.RemoveAllBucketNotification is not mocked")
+		return
+	}
+	return m.RemoveAllBucketNotificationFunc(ctx, bucketName)
+}
+
+// ListenBucketNotification calls m.ListenBucketNotificationFunc.
+func (m *MockClient) ListenBucketNotification(ctx context.Context, bucketName, prefix, suffix string, events []string) <-chan notification.Info {
+	if m.ListenBucketNotificationFunc == nil {
+		panic("minio: MockClient.ListenBucketNotification is not mocked")
+	}
+	return m.ListenBucketNotificationFunc(ctx, bucketName, prefix, suffix, events)
+}
+
+// ListenNotification calls m.ListenNotificationFunc.
+func (m *MockClient) ListenNotification(ctx context.Context, prefix, suffix string, events []string) <-chan notification.Info {
+	if m.ListenNotificationFunc == nil {
+		panic("minio: MockClient.ListenNotification is not mocked")
+	}
+	return m.ListenNotificationFunc(ctx, prefix, suffix, events)
+}
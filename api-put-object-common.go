@@ -129,6 +129,28 @@ func OptimalPartInfo(objectSize int64, configuredPartSize uint64) (totalPartsCou
 	return totalPartsCount, partSize, lastPartSize, nil
 }
 
+// optimalPartInfo - calculates the part info like OptimalPartInfo. If
+// the part size is not configured and the client has a memory budget,
+// parts are kept small enough for numBuffers of them to fit the
+// budget, as long as the object still fits in maxPartsCount parts.
+// Uploads of unknown size are then limited to maxPartsCount parts of
+// the chosen size.
+func (c *Client) optimalPartInfo(objectSize int64, configuredPartSize uint64, numBuffers int) (totalPartsCount int, partSize, lastPartSize int64, err error) {
+	if configuredPartSize == 0 && c.uploadBuffers != nil {
+		// Smallest part size the object fits in.
+		required := int64(absMinPartSize)
+		if objectSize > 0 {
+			required = max(required, (objectSize+maxPartsCount-1)/maxPartsCount)
+		}
+		budget := c.uploadBuffers.limit / int64(max(numBuffers, 1))
+		budget = max(budget/absMinPartSize*absMinPartSize, required)
+		if _, defaultPartSize, _, err := OptimalPartInfo(objectSize, 0); err == nil && budget < defaultPartSize {
+			configuredPartSize = uint64(budget)
+		}
+	}
+	return OptimalPartInfo(objectSize, configuredPartSize)
+}
+
 // getUploadID - fetch upload id if already present for an object name
 // or initiate a new request to fetch a new upload id.
 func (c *Client) newUploadID(ctx context.Context, bucketName, objectName string, opts PutObjectOptions) (uploadID string, err error) {
@@ -60,5 +60,11 @@ func (c *Client) FPutObject(ctx context.Context, bucketName, objectName, filePat
 			opts.ContentType = "application/octet-stream"
 		}
 	}
+	if opts.CheckpointFile != "" && c.isResumableUpload(fileSize, opts) {
+		if err = opts.validate(c); err != nil {
+			return UploadInfo{}, err
+		}
+		return c.fPutObjectResumable(ctx, bucketName, objectName, fileReader, fileSize, fileStat.ModTime(), opts)
+	}
 	return c.PutObject(ctx, bucketName, objectName, fileReader, fileSize, opts)
 }
@@ -73,7 +73,7 @@ func (c *Client) putObjectMultipartNoStream(ctx context.Context, bucketName, obj
 	var complMultipartUpload completeMultipartUpload
 
 	// Calculate the optimal parts info for a given size.
-	totalPartsCount, partSize, _, err := OptimalPartInfo(-1, opts.PartSize)
+	totalPartsCount, partSize, _, err := c.optimalPartInfo(-1, opts.PartSize, 1)
 	if err != nil {
 		return UploadInfo{}, err
 	}
@@ -106,7 +106,12 @@ func (c *Client) putObjectMultipartNoStream(ctx context.Context, bucketName, obj
 	partsInfo := make(map[int]ObjectPart)
 
 	// Create a buffer.
-	buf := make([]byte, partSize)
+	buffers := c.newPartBuffers(1, partSize)
+	buf, err := buffers.get(ctx)
+	if err != nil {
+		return UploadInfo{}, err
+	}
+	defer buffers.put(buf)
 
 	// Create checksums
 	// CRC32C is ~50% faster on AMD64 @ 30GB/s
@@ -152,7 +157,7 @@ func (c *Client) putObjectMultipartNoStream(ctx context.Context, bucketName, obj
 			customHeader.Set(opts.AutoChecksum.Key(), base64.StdEncoding.EncodeToString(cSum))
 		}
 
-		p := uploadPartParams{bucketName: bucketName, objectName: objectName, uploadID: uploadID, reader: rd, partNumber: partNumber, md5Base64: md5Base64, sha256Hex: sha256Hex, size: int64(length), sse: opts.ServerSideEncryption, streamSha256: !opts.DisableContentSha256, customHeader: customHeader}
+		p := uploadPartParams{bucketName: bucketName, objectName: objectName, uploadID: uploadID, reader: rd, partNumber: partNumber, md5Base64: md5Base64, sha256Hex: sha256Hex, size: int64(length), sse: opts.ServerSideEncryption, streamSha256: !opts.DisableContentSha256, customHeader: customHeader, progress: opts.ProgressListener}
 		// Proceed to upload the part.
 		objPart, uerr := c.uploadPart(ctx, p)
 		if uerr != nil {
@@ -278,6 +283,7 @@ type uploadPartParams struct {
 	streamSha256 bool
 	customHeader http.Header
 	trailer      http.Header
+	progress     ProgressListener
 }
 
 // uploadPart - Uploads a part in a multipart upload.
@@ -321,30 +327,34 @@ func (c *Client) uploadPart(ctx context.Context, p uploadPartParams) (ObjectPart
 		p.sse.Marshal(p.customHeader)
 	}
 
+	progress := newTransferProgress(p.progress, p.bucketName, p.objectName).part(p.partNumber, p.size)
 	reqMetadata := requestMetadata{
 		bucketName:       p.bucketName,
 		objectName:       p.objectName,
 		queryValues:      urlValues,
 		customHeader:     p.customHeader,
-		contentBody:      p.reader,
+		contentBody:      progress.reader(p.reader),
 		contentLength:    p.size,
 		contentMD5Base64: p.md5Base64,
 		contentSHA256Hex: p.sha256Hex,
 		streamSha256:     p.streamSha256,
 		trailer:          p.trailer,
 	}
+	if progress != nil {
+		reqMetadata.onRetry = progress.retried
+	}
 
 	// Execute PUT on each part.
+	progress.started()
 	resp, err := c.executeMethod(ctx, http.MethodPut, reqMetadata)
 	defer closeResponse(resp)
+	if err == nil && resp != nil && resp.StatusCode != http.StatusOK {
+		err = httpRespToErrorResponse(resp, p.bucketName, p.objectName)
+	}
+	progress.done(err)
 	if err != nil {
 		return ObjectPart{}, err
 	}
-	if resp != nil {
-		if resp.StatusCode != http.StatusOK {
-			return ObjectPart{}, httpRespToErrorResponse(resp, p.bucketName, p.objectName)
-		}
-	}
 	// Once successfully uploaded, return completed part.
 	h := resp.Header
 	objPart := ObjectPart{
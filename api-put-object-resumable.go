@@ -0,0 +1,333 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/minio/minio-go/v7/internal/json"
+	"github.com/minio/minio-go/v7/pkg/s3utils"
+)
+
+// uploadCheckpoint - on-disk state of a resumable multipart upload.
+type uploadCheckpoint struct {
+	Bucket   string       `json:"bucket"`
+	Object   string       `json:"object"`
+	UploadID string       `json:"uploadId"`
+	Size     int64        `json:"size"`
+	ModTime  time.Time    `json:"modTime"`
+	PartSize int64        `json:"partSize"`
+	Checksum ChecksumType `json:"checksum"`
+	Parts    []ObjectPart `json:"parts"`
+}
+
+// matches - returns true if the checkpoint describes the same upload.
+func (cp uploadCheckpoint) matches(other uploadCheckpoint) bool {
+	return cp.Bucket == other.Bucket &&
+		cp.Object == other.Object &&
+		cp.Size == other.Size &&
+		cp.ModTime.Equal(other.ModTime) &&
+		cp.PartSize == other.PartSize &&
+		cp.Checksum == other.Checksum
+}
+
+// readUploadCheckpoint - reads a checkpoint file, a missing file is
+// not an error and returns an empty checkpoint.
+func readUploadCheckpoint(path string) (cp uploadCheckpoint, err error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return cp, nil
+		}
+		return cp, err
+	}
+	if err = json.Unmarshal(data, &cp); err != nil {
+		// A corrupt checkpoint only means we start over.
+		return uploadCheckpoint{}, nil
+	}
+	return cp, nil
+}
+
+// writeUploadCheckpoint - atomically replaces the checkpoint file.
+func writeUploadCheckpoint(path string, cp uploadCheckpoint) error {
+	data, err := json.Marshal(cp)
+	if err != nil {
+		return err
+	}
+	tmpPath := path + ".tmp"
+	if err = os.WriteFile(tmpPath, data, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
+
+// fPutObjectResumable - uploads a file using multipart upload while
+// recording every completed part in opts.CheckpointFile. If the
+// checkpoint describes an upload of the same file which is still
+// active on the server, only the missing parts are uploaded.
+//
+// Unlike the other multipart uploaders the upload is not aborted on
+// error, the checkpoint is kept so that a later call can resume it.
+func (c *Client) fPutObjectResumable(ctx context.Context, bucketName, objectName string,
+	reader io.ReaderAt, size int64, modTime time.Time, opts PutObjectOptions,
+) (info UploadInfo, err error) {
+	// Input validation.
+	if err = s3utils.CheckValidBucketName(bucketName); err != nil {
+		return UploadInfo{}, err
+	}
+	if err = s3utils.CheckValidObjectName(objectName); err != nil {
+		return UploadInfo{}, err
+	}
+	ctx = withBandwidthLimit(ctx, true, opts.BandwidthLimit)
+
+	progress := newTransferProgress(opts.ProgressListener, bucketName, objectName)
+	progress.started(size)
+	defer func() {
+		progress.completed(err)
+	}()
+
+	// Calculate the optimal parts info for a given size.
+	totalPartsCount, partSize, lastPartSize, err := OptimalPartInfo(size, opts.PartSize)
+	if err != nil {
+		return UploadInfo{}, err
+	}
+	opts.AutoChecksum.SetDefault(ChecksumCRC32C)
+	if opts.Checksum.IsSet() {
+		opts.AutoChecksum = opts.Checksum
+	}
+	withChecksum := c.trailingHeaderSupport
+	if withChecksum {
+		addAutoChecksumHeaders(&opts)
+	}
+
+	cp := uploadCheckpoint{
+		Bucket:   bucketName,
+		Object:   objectName,
+		Size:     size,
+		ModTime:  modTime.UTC(),
+		PartSize: partSize,
+	}
+	if withChecksum {
+		cp.Checksum = opts.AutoChecksum
+	}
+
+	checkpointFile := opts.CheckpointFile
+
+	// Parts already uploaded and confirmed by the server.
+	uploadedParts := make(map[int]ObjectPart)
+
+	saved, err := readUploadCheckpoint(checkpointFile)
+	if err != nil {
+		return UploadInfo{}, err
+	}
+	if saved.UploadID != "" && saved.matches(cp) {
+		listedParts, lerr := c.listObjectParts(ctx, bucketName, objectName, saved.UploadID)
+		switch {
+		case lerr == nil:
+			cp.UploadID = saved.UploadID
+			for _, part := range saved.Parts {
+				listed, ok := listedParts[part.PartNumber]
+				if ok && listed.ETag == part.ETag && listed.Size == part.Size {
+					uploadedParts[part.PartNumber] = part
+				}
+			}
+		case ToErrorResponse(lerr).Code == NoSuchUpload:
+			// Upload was aborted or expired, start over.
+		default:
+			return UploadInfo{}, lerr
+		}
+	}
+
+	if cp.UploadID == "" {
+		// Initiate a new multipart upload.
+		cp.UploadID, err = c.newUploadID(ctx, bucketName, objectName, opts)
+		if err != nil {
+			return UploadInfo{}, err
+		}
+	}
+	delete(opts.UserMetadata, "X-Amz-Checksum-Algorithm")
+
+	saveCheckpoint := func() error {
+		cp.Parts = cp.Parts[:0]
+		for _, part := range uploadedParts {
+			cp.Parts = append(cp.Parts, part)
+		}
+		sort.Slice(cp.Parts, func(i, j int) bool { return cp.Parts[i].PartNumber < cp.Parts[j].PartNumber })
+		return writeUploadCheckpoint(checkpointFile, cp)
+	}
+	if err = saveCheckpoint(); err != nil {
+		return UploadInfo{}, err
+	}
+
+	partitionCtx, partitionCancel := context.WithCancel(ctx)
+	defer partitionCancel()
+
+	// Declare a channel that sends the next part number to be uploaded.
+	uploadPartsCh := make(chan uploadPartReq)
+
+	// Declare a channel that sends back the response of a part upload.
+	uploadedPartsCh := make(chan uploadedPartRes)
+
+	// Send each missing part number to the channel to be processed.
+	var missingParts []int
+	for p := 1; p <= totalPartsCount; p++ {
+		if _, ok := uploadedParts[p]; !ok {
+			missingParts = append(missingParts, p)
+		}
+	}
+	go func() {
+		defer close(uploadPartsCh)
+		for _, p := range missingParts {
+			select {
+			case <-partitionCtx.Done():
+				return
+			case uploadPartsCh <- uploadPartReq{PartNum: p}:
+			}
+		}
+	}()
+
+	for w := 1; w <= opts.getNumThreads(); w++ {
+		go func() {
+			for uploadReq := range uploadPartsCh {
+				readOffset := int64(uploadReq.PartNum-1) * partSize
+				readSize := partSize
+				if uploadReq.PartNum == totalPartsCount {
+					readOffset = size - lastPartSize
+					readSize = lastPartSize
+				}
+
+				sectionReader := newHook(io.NewSectionReader(reader, readOffset, readSize), opts.Progress)
+				trailer := make(http.Header, 1)
+				if withChecksum {
+					crc := opts.AutoChecksum.Hasher()
+					trailer.Set(opts.AutoChecksum.Key(), base64.StdEncoding.EncodeToString(crc.Sum(nil)))
+					sectionReader = newHashReaderWrapper(sectionReader, crc, func(hash []byte) {
+						trailer.Set(opts.AutoChecksum.Key(), base64.StdEncoding.EncodeToString(hash))
+					})
+				}
+
+				objPart, err := c.uploadPart(partitionCtx, uploadPartParams{
+					bucketName:   bucketName,
+					objectName:   objectName,
+					uploadID:     cp.UploadID,
+					reader:       sectionReader,
+					partNumber:   uploadReq.PartNum,
+					size:         readSize,
+					sse:          opts.ServerSideEncryption,
+					streamSha256: !opts.DisableContentSha256,
+					trailer:      trailer,
+					progress:     opts.ProgressListener,
+				})
+				select {
+				case <-partitionCtx.Done():
+					return
+				case uploadedPartsCh <- uploadedPartRes{Error: err, PartNum: uploadReq.PartNum, Size: readSize, Part: objPart}:
+				}
+				if err != nil {
+					return
+				}
+			}
+		}()
+	}
+
+	// Gather the responses and record every completed part.
+	for range len(missingParts) {
+		select {
+		case <-ctx.Done():
+			return UploadInfo{}, ctx.Err()
+		case uploadRes := <-uploadedPartsCh:
+			if uploadRes.Error != nil {
+				return UploadInfo{}, uploadRes.Error
+			}
+			uploadedParts[uploadRes.PartNum] = uploadRes.Part
+			if err = saveCheckpoint(); err != nil {
+				return UploadInfo{}, err
+			}
+		}
+	}
+
+	// Loop over total uploaded parts to save them in
+	// Parts array before completing the multipart request.
+	var complMultipartUpload completeMultipartUpload
+	allParts := make([]ObjectPart, 0, totalPartsCount)
+	var totalUploadedSize int64
+	for p := 1; p <= totalPartsCount; p++ {
+		part, ok := uploadedParts[p]
+		if !ok {
+			return UploadInfo{}, errInvalidArgument(fmt.Sprintf("Missing part number %d", p))
+		}
+		allParts = append(allParts, part)
+		totalUploadedSize += part.Size
+		complMultipartUpload.Parts = append(complMultipartUpload.Parts, CompletePart{
+			ETag:              part.ETag,
+			PartNumber:        part.PartNumber,
+			ChecksumCRC32:     part.ChecksumCRC32,
+			ChecksumCRC32C:    part.ChecksumCRC32C,
+			ChecksumSHA1:      part.ChecksumSHA1,
+			ChecksumSHA256:    part.ChecksumSHA256,
+			ChecksumCRC64NVME: part.ChecksumCRC64NVME,
+		})
+	}
+
+	// Verify if we uploaded all the data.
+	if totalUploadedSize != size {
+		return UploadInfo{}, errUnexpectedEOF(totalUploadedSize, size, bucketName, objectName)
+	}
+
+	opts = PutObjectOptions{
+		ServerSideEncryption: opts.ServerSideEncryption,
+		AutoChecksum:         opts.AutoChecksum,
+	}
+	if withChecksum {
+		applyAutoChecksum(&opts, allParts)
+	}
+
+	uploadInfo, err := c.completeMultipartUpload(ctx, bucketName, objectName, cp.UploadID, complMultipartUpload, opts)
+	if err != nil {
+		return UploadInfo{}, err
+	}
+
+	// Upload is complete, the checkpoint is no longer needed.
+	if rerr := os.Remove(checkpointFile); rerr != nil && !errors.Is(rerr, os.ErrNotExist) {
+		return UploadInfo{}, rerr
+	}
+
+	uploadInfo.Size = totalUploadedSize
+	return uploadInfo, nil
+}
+
+// isResumableUpload - returns true if an upload of the given size
+// would be done as multipart upload, which can be resumed.
+func (c *Client) isResumableUpload(size int64, opts PutObjectOptions) bool {
+	if opts.DisableMultipart || s3utils.IsGoogleEndpoint(*c.endpointURL) {
+		return false
+	}
+	partSize := opts.PartSize
+	if partSize == 0 {
+		partSize = minPartSize
+	}
+	return size > int64(partSize)
+}
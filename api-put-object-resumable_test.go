@@ -0,0 +1,173 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+// multipartTestServer is a minimal single object multipart upload server.
+type multipartTestServer struct {
+	mu        sync.Mutex
+	uploadID  string
+	parts     map[int][]byte
+	uploaded  []int
+	object    []byte
+	aborted   bool
+	failPart  int
+	failCount int
+}
+
+func (s *multipartTestServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	q := r.URL.Query()
+	switch {
+	case r.Method == http.MethodPost && q.Has("uploads"):
+		s.uploadID = "upload-" + strconv.Itoa(len(s.uploadID))
+		s.parts = make(map[int][]byte)
+		xml.NewEncoder(w).Encode(initiateMultipartUploadResult{UploadID: s.uploadID})
+	case q.Get("uploadId") != "" && q.Get("uploadId") != s.uploadID:
+		w.WriteHeader(http.StatusNotFound)
+		xml.NewEncoder(w).Encode(ErrorResponse{Code: NoSuchUpload})
+	case r.Method == http.MethodPut && q.Has("partNumber"):
+		partNumber, _ := strconv.Atoi(q.Get("partNumber"))
+		if partNumber == s.failPart && s.failCount > 0 {
+			s.failCount--
+			w.WriteHeader(http.StatusBadRequest)
+			xml.NewEncoder(w).Encode(ErrorResponse{Code: "BadDigest"})
+			return
+		}
+		data, _ := io.ReadAll(r.Body)
+		s.parts[partNumber] = data
+		s.uploaded = append(s.uploaded, partNumber)
+		sum := md5.Sum(data)
+		w.Header().Set("ETag", "\""+hex.EncodeToString(sum[:])+"\"")
+	case r.Method == http.MethodGet && q.Has("uploadId"):
+		var result ListObjectPartsResult
+		for partNumber, data := range s.parts {
+			sum := md5.Sum(data)
+			result.ObjectParts = append(result.ObjectParts, ObjectPart{
+				PartNumber: partNumber,
+				ETag:       "\"" + hex.EncodeToString(sum[:]) + "\"",
+				Size:       int64(len(data)),
+			})
+		}
+		sort.Slice(result.ObjectParts, func(i, j int) bool {
+			return result.ObjectParts[i].PartNumber < result.ObjectParts[j].PartNumber
+		})
+		xml.NewEncoder(w).Encode(result)
+	case r.Method == http.MethodPost && q.Has("uploadId"):
+		var complete completeMultipartUpload
+		xml.NewDecoder(r.Body).Decode(&complete)
+		s.object = nil
+		for _, part := range complete.Parts {
+			s.object = append(s.object, s.parts[part.PartNumber]...)
+		}
+		s.uploadID = ""
+		io.WriteString(w, `<CompleteMultipartUploadResult><Bucket>bucket</Bucket><Key>object</Key><ETag>"object-etag"</ETag></CompleteMultipartUploadResult>`)
+	case r.Method == http.MethodDelete && q.Has("uploadId"):
+		s.aborted = true
+		s.uploadID = ""
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotImplemented)
+	}
+}
+
+func TestFPutObjectResumable(t *testing.T) {
+	data := make([]byte, 4*absMinPartSize+17)
+	rand.Read(data)
+
+	dir := t.TempDir()
+	filePath := filepath.Join(dir, "object")
+	if err := os.WriteFile(filePath, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	checkpoint := filepath.Join(dir, "object.checkpoint")
+
+	mts := &multipartTestServer{failPart: 3, failCount: 1}
+	srv := httptest.NewServer(mts)
+	defer srv.Close()
+
+	clnt, err := New(srv.Listener.Addr().String(), &Options{Region: "us-east-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opts := PutObjectOptions{
+		PartSize:             absMinPartSize,
+		NumThreads:           1,
+		CheckpointFile:       checkpoint,
+		DisableContentSha256: true,
+	}
+
+	// First attempt fails on part 3 and keeps the checkpoint.
+	if _, err = clnt.FPutObject(context.Background(), "bucket", "object", filePath, opts); err == nil {
+		t.Fatal("Expected upload to fail")
+	}
+	cp, err := readUploadCheckpoint(checkpoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cp.UploadID == "" || len(cp.Parts) != 2 {
+		t.Fatalf("Expected checkpoint with 2 parts, got %+v", cp)
+	}
+
+	// Second attempt uploads only the missing parts.
+	mts.uploaded = nil
+	if _, err = clnt.FPutObject(context.Background(), "bucket", "object", filePath, opts); err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{3, 4, 5}; !slicesEqual(mts.uploaded, want) {
+		t.Fatalf("Expected parts %v to be uploaded, got %v", want, mts.uploaded)
+	}
+	if !bytes.Equal(mts.object, data) {
+		t.Fatal("Uploaded content does not match")
+	}
+	if _, err = os.Stat(checkpoint); !os.IsNotExist(err) {
+		t.Fatalf("Expected checkpoint to be removed, got %v", err)
+	}
+}
+
+func slicesEqual(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -212,6 +212,7 @@ func (c *Client) putObjectMultipartStreamFromReadAt(ctx context.Context, bucketN
 					streamSha256: !opts.DisableContentSha256,
 					sha256Hex:    "",
 					trailer:      trailer,
+					progress:     opts.ProgressListener,
 				}
 				objPart, err := c.uploadPart(ctx, p)
 				if err != nil {
@@ -307,7 +308,7 @@ func (c *Client) putObjectMultipartStreamOptionalChecksum(ctx context.Context, b
 	}
 
 	// Calculate the optimal parts info for a given size.
-	totalPartsCount, partSize, lastPartSize, err := OptimalPartInfo(size, opts.PartSize)
+	totalPartsCount, partSize, lastPartSize, err := c.optimalPartInfo(size, opts.PartSize, 1)
 	if err != nil {
 		return UploadInfo{}, err
 	}
@@ -342,7 +343,12 @@ func (c *Client) putObjectMultipartStreamOptionalChecksum(ctx context.Context, b
 	partsInfo := make(map[int]ObjectPart)
 
 	// Create a buffer.
-	buf := make([]byte, partSize)
+	buffers := c.newPartBuffers(1, partSize)
+	buf, err := buffers.get(ctx)
+	if err != nil {
+		return UploadInfo{}, err
+	}
+	defer buffers.put(buf)
 
 	// Avoid declaring variables in the for loop
 	var md5Base64 string
@@ -380,7 +386,7 @@ func (c *Client) putObjectMultipartStreamOptionalChecksum(ctx context.Context, b
 		// Update progress reader appropriately to the latest offset
 		// as we read from the source.
 		hooked := newHook(bytes.NewReader(buf[:length]), opts.Progress)
-		p := uploadPartParams{bucketName: bucketName, objectName: objectName, uploadID: uploadID, reader: hooked, partNumber: partNumber, md5Base64: md5Base64, size: partSize, sse: opts.ServerSideEncryption, streamSha256: !opts.DisableContentSha256, customHeader: customHeader}
+		p := uploadPartParams{bucketName: bucketName, objectName: objectName, uploadID: uploadID, reader: hooked, partNumber: partNumber, md5Base64: md5Base64, size: partSize, sse: opts.ServerSideEncryption, streamSha256: !opts.DisableContentSha256, customHeader: customHeader, progress: opts.ProgressListener}
 		objPart, uerr := c.uploadPart(ctx, p)
 		if uerr != nil {
 			return UploadInfo{}, uerr
@@ -466,7 +472,7 @@ func (c *Client) putObjectMultipartStreamParallel(ctx context.Context, bucketNam
 	defer cancel()
 
 	// Calculate the optimal parts info for a given size.
-	totalPartsCount, partSize, _, err := OptimalPartInfo(-1, opts.PartSize)
+	totalPartsCount, partSize, _, err := c.optimalPartInfo(-1, opts.PartSize, int(opts.NumThreads))
 	if err != nil {
 		return UploadInfo{}, err
 	}
@@ -498,13 +504,8 @@ func (c *Client) putObjectMultipartStreamParallel(ctx context.Context, bucketNam
 	// Initialize parts uploaded map.
 	partsInfo := make(map[int]ObjectPart)
 
-	// Create a buffer.
-	nBuffers := int64(opts.NumThreads)
-	bufs := make(chan []byte, nBuffers)
-	all := make([]byte, nBuffers*partSize)
-	for i := int64(0); i < nBuffers; i++ {
-		bufs <- all[i*partSize : i*partSize+partSize]
-	}
+	// Create the buffers.
+	buffers := c.newPartBuffers(int(opts.NumThreads), partSize)
 
 	var wg sync.WaitGroup
 	var mu sync.Mutex
@@ -515,27 +516,32 @@ func (c *Client) putObjectMultipartStreamParallel(ctx context.Context, bucketNam
 	// Part number always starts with '1'.
 	var partNumber int
 	for partNumber = 1; partNumber <= totalPartsCount; partNumber++ {
-		// Proceed to upload the part.
-		var buf []byte
-		select {
-		case buf = <-bufs:
-		case err = <-errCh:
-			cancel()
+		// Proceed to upload the part, failed parts cancel ctx.
+		buf, berr := buffers.get(ctx)
+		if berr != nil {
 			wg.Wait()
+			select {
+			case err = <-errCh:
+			default:
+				err = berr
+			}
 			return UploadInfo{}, err
 		}
 
 		if int64(len(buf)) != partSize {
+			buffers.put(buf)
 			return UploadInfo{}, fmt.Errorf("read buffer < %d than expected partSize: %d", len(buf), partSize)
 		}
 
 		length, rerr := readFull(reader, buf)
 		if rerr == io.EOF && partNumber > 1 {
 			// Done
+			buffers.put(buf)
 			break
 		}
 
 		if rerr != nil && rerr != io.ErrUnexpectedEOF && err != io.EOF {
+			buffers.put(buf)
 			cancel()
 			wg.Wait()
 			return UploadInfo{}, rerr
@@ -564,6 +570,9 @@ func (c *Client) putObjectMultipartStreamParallel(ctx context.Context, bucketNam
 			}
 
 			defer wg.Done()
+			// Send buffer back so it can be reused.
+			defer buffers.put(buf)
+
 			p := uploadPartParams{
 				bucketName:   bucketName,
 				objectName:   objectName,
@@ -575,10 +584,12 @@ func (c *Client) putObjectMultipartStreamParallel(ctx context.Context, bucketNam
 				sse:          opts.ServerSideEncryption,
 				streamSha256: !opts.DisableContentSha256,
 				customHeader: customHeader,
+				progress:     opts.ProgressListener,
 			}
 			objPart, uerr := c.uploadPart(ctx, p)
 			if uerr != nil {
 				errCh <- uerr
+				cancel()
 				return
 			}
 
@@ -586,9 +597,6 @@ func (c *Client) putObjectMultipartStreamParallel(ctx context.Context, bucketNam
 			mu.Lock()
 			partsInfo[partNumber] = objPart
 			mu.Unlock()
-
-			// Send buffer back so it can be reused.
-			bufs <- buf
 		}(partNumber)
 
 		// Save successfully uploaded size.
@@ -736,17 +744,23 @@ func (c *Client) putObjectDo(ctx context.Context, bucketName, objectName string,
 	// Set headers.
 	customHeader := opts.Header()
 
+	// Single part uploads only report transferred bytes and retries.
+	progress := newTransferProgress(opts.ProgressListener, bucketName, objectName).part(0, size)
+
 	// Populate request metadata.
 	reqMetadata := requestMetadata{
 		bucketName:       bucketName,
 		objectName:       objectName,
 		customHeader:     customHeader,
-		contentBody:      reader,
+		contentBody:      progress.reader(reader),
 		contentLength:    size,
 		contentMD5Base64: md5Base64,
 		contentSHA256Hex: sha256Hex,
 		streamSha256:     !opts.DisableContentSha256,
 	}
+	if progress != nil {
+		reqMetadata.onRetry = progress.retried
+	}
 	// Add CRC when client supports it, MD5 is not set, not Google and we don't add SHA256 to chunks.
 	addCrc := c.trailingHeaderSupport && md5Base64 == "" && !s3utils.IsGoogleEndpoint(*c.endpointURL) && (opts.DisableContentSha256 || c.secure)
 	if opts.Checksum.IsSet() {
@@ -0,0 +1,106 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/minio/minio-go/v7/pkg/s3utils"
+)
+
+// errObjectWriterAborted is returned by an ObjectWriter after Abort.
+var errObjectWriterAborted = errors.New("object writer aborted")
+
+// ObjectWriter uploads everything written to it as a single object.
+// Data is buffered into parts of opts.PartSize and uploaded as a
+// multipart upload while writing. The object is only created once
+// Close or Complete returns successfully.
+type ObjectWriter struct {
+	pw   *io.PipeWriter
+	done chan struct{}
+
+	// Set by the upload goroutine before done is closed.
+	info UploadInfo
+	err  error
+}
+
+// NewObjectWriter returns a writer which uploads everything written
+// to it to objectName. The upload is completed by Close or Complete
+// and abandoned by Abort, the writer must always be either completed
+// or aborted to release the resources held by the upload.
+func (c *Client) NewObjectWriter(ctx context.Context, bucketName, objectName string, opts PutObjectOptions) (*ObjectWriter, error) {
+	// Input validation.
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return nil, err
+	}
+	if err := s3utils.CheckValidObjectName(objectName); err != nil {
+		return nil, err
+	}
+	if opts.DisableMultipart {
+		return nil, errInvalidArgument("ObjectWriter cannot be used with multipart disabled.")
+	}
+	if err := opts.validate(c); err != nil {
+		return nil, err
+	}
+
+	pr, pw := io.Pipe()
+	w := &ObjectWriter{
+		pw:   pw,
+		done: make(chan struct{}),
+	}
+	go func() {
+		defer close(w.done)
+		w.info, w.err = c.putObjectCommon(ctx, bucketName, objectName, pr, -1, opts)
+		// Unblock any pending Write when the upload stopped early.
+		if w.err != nil {
+			pr.CloseWithError(w.err)
+		} else {
+			pr.Close()
+		}
+	}()
+	return w, nil
+}
+
+// Write uploads p as part of the object. An error is returned if the
+// upload failed or the writer was already closed or aborted.
+func (w *ObjectWriter) Write(p []byte) (int, error) {
+	return w.pw.Write(p)
+}
+
+// Complete finishes the upload and returns the information of the
+// uploaded object. Calling Complete again returns the same result.
+func (w *ObjectWriter) Complete() (UploadInfo, error) {
+	w.pw.Close()
+	<-w.done
+	return w.info, w.err
+}
+
+// Close finishes the upload, see Complete.
+func (w *ObjectWriter) Close() error {
+	_, err := w.Complete()
+	return err
+}
+
+// Abort abandons the upload, all parts uploaded so far are removed.
+// Abort has no effect once the upload is completed.
+func (w *ObjectWriter) Abort() {
+	w.pw.CloseWithError(errObjectWriterAborted)
+	<-w.done
+}
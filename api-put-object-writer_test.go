@@ -0,0 +1,86 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+func TestObjectWriter(t *testing.T) {
+	data := make([]byte, 2*absMinPartSize+17)
+	rand.Read(data)
+
+	mts := &multipartTestServer{}
+	srv := httptest.NewServer(mts)
+	defer srv.Close()
+
+	clnt, err := New(srv.Listener.Addr().String(), &Options{Region: "us-east-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := PutObjectOptions{PartSize: absMinPartSize, DisableContentSha256: true}
+
+	w, err := clnt.NewObjectWriter(context.Background(), "bucket", "object", opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Write in chunks which do not line up with the part size.
+	for chunk := range slices.Chunk(data, 1000) {
+		if _, err = w.Write(chunk); err != nil {
+			t.Fatal(err)
+		}
+	}
+	info, err := w.Complete()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.ETag != "object-etag" {
+		t.Fatalf("Expected ETag object-etag, got %q", info.ETag)
+	}
+	if !bytes.Equal(mts.object, data) {
+		t.Fatal("Uploaded content does not match")
+	}
+	if err = w.Close(); err != nil {
+		t.Fatalf("Expected Close after Complete to succeed, got %v", err)
+	}
+
+	// An aborted writer removes the upload and rejects further writes.
+	mts.object = nil
+	w, err = clnt.NewObjectWriter(context.Background(), "bucket", "object", opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = w.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	w.Abort()
+	if !mts.aborted || mts.object != nil {
+		t.Fatal("Expected upload to be aborted")
+	}
+	if _, err = w.Write(data); err == nil {
+		t.Fatal("Expected write after abort to fail")
+	}
+	if err = w.Close(); err == nil {
+		t.Fatal("Expected close after abort to fail")
+	}
+}
@@ -108,7 +108,25 @@ type PutObjectOptions struct {
 	// fill them serially and upload them in parallel.
 	// This can be used for faster uploads on non-seekable or slow-to-seek input.
 	ConcurrentStreamParts bool
-	Internal              AdvancedPutOptions
+
+	// CheckpointFile is the path of a file used by FPutObject to record
+	// the progress of a multipart upload. If the upload is interrupted
+	// a later FPutObject call with the same file and CheckpointFile
+	// resumes it by uploading only the missing parts. The checkpoint is
+	// removed once the upload completes.
+	CheckpointFile string
+
+	// ProgressListener is notified about the start and end of the
+	// upload and about every part, see ProgressEvent. Unlike Progress
+	// it reports which part is in flight and when a part is retried.
+	ProgressListener ProgressListener
+
+	// BandwidthLimit caps the upload throughput of this call in bytes
+	// per second, overriding Options.BandwidthLimit. All the parts of a
+	// multipart upload share the limit.
+	BandwidthLimit int64
+
+	Internal AdvancedPutOptions
 
 	customHeaders http.Header
 }
@@ -323,11 +341,18 @@ func (c *Client) PutObject(ctx context.Context, bucketName, objectName string, r
 }
 
 func (c *Client) putObjectCommon(ctx context.Context, bucketName, objectName string, reader io.Reader, size int64, opts PutObjectOptions) (info UploadInfo, err error) {
+	progress := newTransferProgress(opts.ProgressListener, bucketName, objectName)
+	progress.started(size)
+	defer func() {
+		progress.completed(err)
+	}()
+
 	// Check for largest object size allowed.
 	if size > int64(maxMultipartPutObjectSize) {
 		return UploadInfo{}, errEntityTooLarge(size, maxMultipartPutObjectSize, bucketName, objectName)
 	}
 	opts.AutoChecksum.SetDefault(ChecksumCRC32C)
+	ctx = withBandwidthLimit(ctx, true, opts.BandwidthLimit)
 
 	// NOTE: Streaming signature is not supported by GCS.
 	if s3utils.IsGoogleEndpoint(*c.endpointURL) {
@@ -380,7 +405,7 @@ func (c *Client) putObjectMultipartStreamNoLength(ctx context.Context, bucketNam
 	var complMultipartUpload completeMultipartUpload
 
 	// Calculate the optimal parts info for a given size.
-	totalPartsCount, partSize, _, err := OptimalPartInfo(-1, opts.PartSize)
+	totalPartsCount, partSize, _, err := c.optimalPartInfo(-1, opts.PartSize, 1)
 	if err != nil {
 		return UploadInfo{}, err
 	}
@@ -413,7 +438,12 @@ func (c *Client) putObjectMultipartStreamNoLength(ctx context.Context, bucketNam
 	partsInfo := make(map[int]ObjectPart)
 
 	// Create a buffer.
-	buf := make([]byte, partSize)
+	buffers := c.newPartBuffers(1, partSize)
+	buf, err := buffers.get(ctx)
+	if err != nil {
+		return UploadInfo{}, err
+	}
+	defer buffers.put(buf)
 
 	// Create checksums
 	// CRC32C is ~50% faster on AMD64 @ 30GB/s
@@ -449,7 +479,7 @@ func (c *Client) putObjectMultipartStreamNoLength(ctx context.Context, bucketNam
 		rd := newHook(bytes.NewReader(buf[:length]), opts.Progress)
 
 		// Proceed to upload the part.
-		p := uploadPartParams{bucketName: bucketName, objectName: objectName, uploadID: uploadID, reader: rd, partNumber: partNumber, md5Base64: md5Base64, size: int64(length), sse: opts.ServerSideEncryption, streamSha256: !opts.DisableContentSha256, customHeader: customHeader}
+		p := uploadPartParams{bucketName: bucketName, objectName: objectName, uploadID: uploadID, reader: rd, partNumber: partNumber, md5Base64: md5Base64, size: int64(length), sse: opts.ServerSideEncryption, streamSha256: !opts.DisableContentSha256, customHeader: customHeader, progress: opts.ProgressListener}
 		objPart, uerr := c.uploadPart(ctx, p)
 		if uerr != nil {
 			return UploadInfo{}, uerr
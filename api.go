@@ -25,6 +25,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"math/rand"
 	"net"
 	"net/http"
@@ -111,6 +112,37 @@ type Client struct {
 
 	trailingHeaderSupport bool
 	maxRetries            int
+
+	// Retry policy unless overridden per call, retries of all requests
+	// are capped by retryBudget if set.
+	defaultRetryPolicy RetryPolicy
+	retryBudget        *retryBudget
+
+	// Client wide bandwidth limits, nil if unlimited.
+	uploadLimiter   *bandwidthLimiter
+	downloadLimiter *bandwidthLimiter
+
+	// Shared part buffers of all uploads, nil if unlimited.
+	uploadBuffers *bufferPool
+
+	hooks RequestHooks
+
+	// Hedging of GET and HEAD requests unless overridden per call.
+	defaultHedgePolicy HedgePolicy
+	hedge              hedgeState
+
+	// Per bucket limits of the requests in flight, nil if unlimited.
+	concurrency *concurrencyLimiters
+
+	// Per attempt limits, zero if disabled.
+	attemptTimeout time.Duration
+	stallTimeout   time.Duration
+
+	// Nodes of a multi-endpoint client, nil for a single endpoint.
+	endpoints *endpointPool
+
+	logger         *slog.Logger
+	logErrorBodies bool
 }
 
 // Options for New method
@@ -158,6 +190,78 @@ type Options struct {
 	// Number of times a request is retried. Defaults to 10 retries if this option is not configured.
 	// Set to 1 to disable retries.
 	MaxRetries int
+
+	// Endpoints are additional nodes of the same deployment as the
+	// endpoint passed to New, using the same credentials and scheme.
+	// Requests are spread over the nodes, a node is taken out of
+	// rotation after consecutive network errors and idempotent requests
+	// are retried on another node. HealthCheck probes failed nodes back
+	// into rotation.
+	Endpoints []string
+
+	// RetryPolicy decides whether and when failed requests are retried,
+	// defaults to a DefaultRetryPolicy with MaxRetries attempts.
+	// WithRetryPolicy overrides it for single calls.
+	RetryPolicy RetryPolicy
+
+	// HedgePolicy enables hedged GET and HEAD requests, a duplicate
+	// request is sent if the first one did not respond in time and the
+	// first response is used. WithHedgePolicy overrides it for single
+	// calls.
+	HedgePolicy HedgePolicy
+
+	// AdaptiveConcurrency, if set, limits the requests in flight per
+	// bucket, shrinking the limit when the server throttles requests
+	// and growing it back on success.
+	AdaptiveConcurrency *AdaptiveConcurrency
+
+	// AttemptTimeout, if set, aborts a request attempt which has not
+	// received the response headers within this time after it is sent,
+	// and again after its body is written. Preparing the request, e.g.
+	// refreshing credentials, is not counted. The attempt fails with
+	// ErrAttemptTimeout and is retried within the context of the call.
+	AttemptTimeout time.Duration
+
+	// StallTimeout, if set, aborts a request attempt whose request or
+	// response body does not move for this long. Stalled uploads fail
+	// with ErrBodyStalled and are retried within the context of the
+	// call, reads of stalled downloads return ErrBodyStalled.
+	StallTimeout time.Duration
+
+	// RetryBudget, if set, caps the retries of all the requests of the
+	// client relative to the successful ones.
+	RetryBudget *RetryBudget
+
+	// BandwidthLimit caps the upload and download throughput, in bytes
+	// per second, shared by all the concurrent requests of the client.
+	// PutObjectOptions and GetObjectOptions can override it per call.
+	BandwidthLimit BandwidthLimit
+
+	// MaxUploadBufferMemory caps the memory, in bytes, used for part
+	// buffers by all the concurrent uploads of the client. Parts wait
+	// for buffers to be released once the limit is reached and part
+	// sizes, if not configured, are chosen to fit the limit. Uploads of
+	// unknown size, e.g. PutObject with size -1, are then limited to
+	// 10000 parts of the chosen size: a 64MiB limit with 4 threads gives
+	// 15MiB parts and objects up to about 146GiB. Set PutObjectOptions
+	// PartSize to upload larger streams. The limit must be at least 5MiB,
+	// the minimum part size. Zero means every upload allocates its own
+	// buffers.
+	MaxUploadBufferMemory int64
+
+	// Hooks, if set, are notified about the start, retries and end of
+	// every request, e.g. to trace them.
+	Hooks RequestHooks
+
+	// Logger, if set, receives a structured record of every request
+	// attempt with its method, URL, headers, status, duration, error
+	// code and attempt number. Credentials in headers and presigned
+	// URLs are redacted. Unlike TraceOn no bodies are logged.
+	Logger *slog.Logger
+
+	// LogErrorBodies adds the body of S3 error responses to the
+	// records of Logger.
+	LogErrorBodies bool
 }
 
 // Global constants.
@@ -321,6 +425,28 @@ func privateNew(endpoint string, opts *Options) (*Client, error) {
 	if opts.MaxRetries > 0 {
 		clnt.maxRetries = opts.MaxRetries
 	}
+	clnt.defaultRetryPolicy = opts.RetryPolicy
+	if clnt.defaultRetryPolicy == nil {
+		clnt.defaultRetryPolicy = DefaultRetryPolicy{MaxRetries: clnt.maxRetries}
+	}
+	clnt.retryBudget = newRetryBudget(opts.RetryBudget)
+	clnt.defaultHedgePolicy = opts.HedgePolicy
+	clnt.concurrency = newConcurrencyLimiters(opts.AdaptiveConcurrency)
+	clnt.attemptTimeout = opts.AttemptTimeout
+	clnt.stallTimeout = opts.StallTimeout
+
+	clnt.uploadLimiter = newBandwidthLimiter(opts.BandwidthLimit.Upload)
+	clnt.downloadLimiter = newBandwidthLimiter(opts.BandwidthLimit.Download)
+	if opts.MaxUploadBufferMemory > 0 && opts.MaxUploadBufferMemory < absMinPartSize {
+		return nil, errInvalidArgument(fmt.Sprintf("MaxUploadBufferMemory %d is smaller than the minimum part size %d", opts.MaxUploadBufferMemory, absMinPartSize))
+	}
+	clnt.uploadBuffers = newBufferPool(opts.MaxUploadBufferMemory)
+	clnt.hooks = opts.Hooks
+	if clnt.endpoints, err = newEndpointPool(endpointURL, opts.Endpoints); err != nil {
+		return nil, err
+	}
+	clnt.logger = opts.Logger
+	clnt.logErrorBodies = opts.LogErrorBodies
 
 	// Return.
 	return clnt, nil
@@ -452,7 +578,11 @@ func (c *Client) HealthCheck(hcDuration time.Duration) (context.CancelFunc, erro
 	probeBucketName := randString(60, rand.NewSource(time.Now().UnixNano()), "probe-health-")
 	ctx, cancelFn := context.WithCancel(context.Background())
 	atomic.StoreInt32(&c.healthStatus, offline)
-	{
+	if c.endpoints != nil {
+		// Multi-endpoint clients stay online, failed nodes are taken
+		// out of rotation and probed back instead.
+		atomic.StoreInt32(&c.healthStatus, online)
+	} else {
 		// Change to online, if we can connect.
 		gctx, gcancel := context.WithTimeout(ctx, 3*time.Second)
 		_, err := c.getBucketLocation(gctx, probeBucketName)
@@ -474,8 +604,10 @@ func (c *Client) HealthCheck(hcDuration time.Duration) (context.CancelFunc, erro
 				atomic.StoreInt32(&c.healthStatus, unknown)
 				return
 			case <-timer.C:
-				// Do health check the first time and ONLY if the connection is marked offline
-				if c.IsOffline() {
+				if c.endpoints != nil {
+					c.probeNodes(ctx, probeBucketName)
+				} else if c.IsOffline() {
+					// Do health check the first time and ONLY if the connection is marked offline
 					gctx, gcancel := context.WithTimeout(context.Background(), 3*time.Second)
 					_, err := c.getBucketLocation(gctx, probeBucketName)
 					gcancel()
@@ -518,6 +650,13 @@ type requestMetadata struct {
 	trailer          http.Header // (http.Request).Trailer. Requires v4 signature.
 
 	expect200OKWithError bool
+
+	// If set called with the error of the previous attempt before
+	// the request is retried.
+	onRetry func(err error)
+
+	// Node of a multi-endpoint client the request is sent to.
+	node *endpointNode
 }
 
 // dumpHTTP - dump HTTP request and response.
@@ -528,14 +667,15 @@ func (c *Client) dumpHTTP(req *http.Request, resp *http.Response) error {
 		return err
 	}
 
-	// Filter out Signature field from Authorization header.
-	origAuth := req.Header.Get("Authorization")
-	if origAuth != "" {
-		req.Header.Set("Authorization", redactSignature(origAuth))
+	// Filter out credentials from headers and presigned URLs.
+	dumpReq := req.Clone(req.Context())
+	dumpReq.Header = redactHeader(req.Header)
+	if dumpReq.URL, err = url.Parse(redactURL(req.URL)); err != nil {
+		return err
 	}
 
 	// Only display request header.
-	reqTrace, err := httputil.DumpRequestOut(req, false)
+	reqTrace, err := httputil.DumpRequestOut(dumpReq, false)
 	if err != nil {
 		return err
 	}
@@ -583,7 +723,9 @@ func (c *Client) dumpHTTP(req *http.Request, resp *http.Response) error {
 // do - execute http request.
 func (c *Client) do(req *http.Request) (resp *http.Response, err error) {
 	defer func() {
-		if IsNetworkOrHostDown(err, false) {
+		// Failed nodes of a multi-endpoint client are taken out of
+		// rotation by executeMethod instead.
+		if c.endpoints == nil && IsNetworkOrHostDown(err, false) {
 			c.markOffline()
 		}
 	}()
@@ -651,8 +793,9 @@ var successStatus = []int{
 }
 
 // executeMethod - instantiates a given method, and retries the
-// request upon any error up to maxRetries attempts in a binomially
-// delayed manner using a standard back off algorithm.
+// request upon any error as decided by the retry policy, by default
+// up to maxRetries attempts in a binomially delayed manner using a
+// standard back off algorithm.
 func (c *Client) executeMethod(ctx context.Context, method string, metadata requestMetadata) (res *http.Response, err error) {
 	if c.IsOffline() {
 		return nil, errors.New(c.endpointURL.String() + " is offline.")
@@ -660,7 +803,7 @@ func (c *Client) executeMethod(ctx context.Context, method string, metadata requ
 
 	var retryable bool       // Indicates if request can be retried.
 	var bodySeeker io.Seeker // Extracted seeker from io.Reader.
-	reqRetry := c.maxRetries // Indicates how many times we can retry the request
+	noRetry := false         // Indicates if the request must not be retried.
 
 	if metadata.contentBody != nil {
 		// Check if body is seekable then it is retryable.
@@ -670,9 +813,7 @@ func (c *Client) executeMethod(ctx context.Context, method string, metadata requ
 			retryable = false
 		}
 		// Retry only when reader is seekable
-		if !retryable {
-			reqRetry = 1
-		}
+		noRetry = !retryable
 
 		// Figure out if the body can be closed - if yes
 		// we will definitely close it upon the function
@@ -695,11 +836,59 @@ func (c *Client) executeMethod(ctx context.Context, method string, metadata requ
 		metadata.trailer.Set(metadata.addCrc.Key(), base64.StdEncoding.EncodeToString(crc.Sum(nil)))
 	}
 
-	for range c.newRetryTimer(ctx, reqRetry, DefaultRetryUnit, DefaultRetryCap, MaxJitter) {
+	uploadLimiter, downloadLimiter := c.bandwidthLimiters(ctx)
+	metadata.contentBody = newThrottledReader(ctx, metadata.contentBody, uploadLimiter)
+
+	var lastAttempt int
+	obs := c.observeRequest(ctx, method, &metadata)
+	ctx = obs.context(ctx)
+	defer func() {
+		obs.finish(lastAttempt, res, err)
+	}()
+
+	// shouldRetry - asks the retry policy if the failed attempt is
+	// retried, setting the delay before the next attempt.
+	var delay time.Duration
+	policy := c.retryPolicy(ctx)
+	shouldRetry := func(r RetryRequest) bool {
+		if noRetry {
+			return false
+		}
+		var ok bool
+		if delay, ok = policy.Retry(ctx, r); !ok {
+			return false
+		}
+		return c.retryBudget.take()
+	}
+
+	// Node of a multi-endpoint client, idempotent requests are retried
+	// on another node.
+	node := metadata.node
+	if node == nil {
+		node = c.endpoints.pick(nil)
+	}
+
+	// Watchdog of the current attempt, it is released with the
+	// response body once handed to the caller.
+	var watchdog *attemptWatchdog
+	defer func() {
+		watchdog.stop()
+	}()
+
+	for attempt := range retryAttempts(ctx, &delay) {
 		// Retry executes the following function body if request has an
-		// error until maxRetries have been exhausted, retry attempts are
-		// performed after waiting for a given period of time in a
-		// binomial fashion.
+		// error until the retry policy gives up, retry attempts are
+		// performed after waiting for the delay it asked for.
+		lastAttempt = attempt
+		if attempt > 0 && metadata.node == nil && isIdempotent(method) {
+			node = c.endpoints.pick(node)
+		}
+		if attempt > 0 {
+			obs.retry(attempt, err)
+			if metadata.onRetry != nil {
+				metadata.onRetry(err)
+			}
+		}
 		if retryable {
 			// Seek back to beginning for each attempt.
 			if _, err = bodySeeker.Seek(0, 0); err != nil {
@@ -710,10 +899,14 @@ func (c *Client) executeMethod(ctx context.Context, method string, metadata requ
 
 		// Instantiate a new request.
 		var req *http.Request
-		req, err = c.newRequest(ctx, method, metadata)
+		watchdog.stop()
+		watchdog = newAttemptWatchdog(ctx, c.attemptTimeout, c.stallTimeout)
+		attemptMetadata := metadata
+		attemptMetadata.node = node
+		attemptMetadata.contentBody = watchdog.uploadReader(metadata.contentBody)
+		req, err = c.newRequest(watchdog.context(ctx), method, attemptMetadata)
 		if err != nil {
-			errResponse := ToErrorResponse(err)
-			if isS3CodeRetryable(errResponse.Code) {
+			if shouldRetry(RetryRequest{Err: err, Attempt: attempt}) {
 				continue // Retry.
 			}
 
@@ -721,9 +914,34 @@ func (c *Client) executeMethod(ctx context.Context, method string, metadata requ
 		}
 
 		// Initiate the request.
-		res, err = c.do(req)
+		limiter := c.concurrency.limiter(metadata.bucketName)
+		var sent time.Time
+		if sent, err = limiter.acquire(ctx); err != nil {
+			return nil, err
+		}
+		start := time.Now()
+		watchdog.sent()
+		if hedge := c.hedgePolicy(ctx); hedge.enabled() && metadata.contentBody == nil &&
+			(method == http.MethodGet || method == http.MethodHead) {
+			res, err = c.doHedged(req, hedge)
+		} else {
+			res, err = c.do(req)
+		}
+		watchdog.disarm()
+		limiter.release(sent, isThrottled(res))
+		if node != nil {
+			if IsNetworkOrHostDown(err, false) {
+				node.markFailed()
+			} else {
+				node.markOnline()
+			}
+		}
 		if err != nil {
-			if isRequestErrorRetryable(ctx, err) {
+			if cause := watchdog.err(); cause != nil {
+				err = cause
+			}
+			c.logAttempt(req, nil, attempt, start, err, nil)
+			if shouldRetry(RetryRequest{Request: req, Err: err, Attempt: attempt}) {
 				// Retry the request
 				continue
 			}
@@ -742,11 +960,19 @@ func (c *Client) executeMethod(ctx context.Context, method string, metadata requ
 
 		if success {
 			if !metadata.expect200OKWithError {
+				c.logAttempt(req, res, attempt, start, nil, nil)
+				c.retryBudget.success()
+				res.Body = newThrottledReadCloser(ctx, watchdog.responseBody(res.Body), downloadLimiter)
+				watchdog = nil
 				return res, nil
 			}
 			errBodyBytes, err = tryParseErrRespFromBody(res)
 			if err == nil && len(errBodyBytes) == 0 {
 				// No S3 XML error is found
+				c.logAttempt(req, res, attempt, start, nil, nil)
+				c.retryBudget.success()
+				res.Body = newThrottledReadCloser(ctx, watchdog.responseBody(res.Body), downloadLimiter)
+				watchdog = nil
 				return res, nil
 			}
 		} else {
@@ -766,6 +992,7 @@ func (c *Client) executeMethod(ctx context.Context, method string, metadata requ
 		// For errors verify if its retryable otherwise fail quickly.
 		errResponse := ToErrorResponse(httpRespToErrorResponse(res, metadata.bucketName, metadata.objectName))
 		err = errResponse
+		c.logAttempt(req, res, attempt, start, err, errBodyBytes)
 
 		// Save the body back again.
 		errBodySeeker.Seek(0, 0) // Seek back to starting point.
@@ -792,29 +1019,26 @@ func (c *Client) executeMethod(ctx context.Context, method string, metadata requ
 				// handle this appropriately.
 				if metadata.bucketName != "" {
 					// Gather Cached location only if bucketName is present.
-					if location, cachedOk := c.bucketLocCache.Get(metadata.bucketName); cachedOk && location != errResponse.Region {
+					if location, cachedOk := c.bucketLocCache.Get(metadata.bucketName); cachedOk && location != errResponse.Region && !noRetry {
 						c.bucketLocCache.Set(metadata.bucketName, errResponse.Region)
+						delay = 0
 						continue // Retry.
 					}
 				} else {
 					// This is for ListBuckets() fallback.
-					if errResponse.Region != metadata.bucketLocation {
+					if errResponse.Region != metadata.bucketLocation && !noRetry {
 						// Retry if the error response has a different region
 						// than the request we just made.
 						metadata.bucketLocation = errResponse.Region
+						delay = 0
 						continue // Retry
 					}
 				}
 			}
 		}
 
-		// Verify if error response code is retryable.
-		if isS3CodeRetryable(errResponse.Code) {
-			continue // Retry.
-		}
-
-		// Verify if http status code is retryable.
-		if isHTTPStatusRetryable(res.StatusCode) {
+		// Verify if the error response is retryable.
+		if shouldRetry(RetryRequest{Request: req, Response: res, Err: err, Attempt: attempt}) {
 			continue // Retry.
 		}
 
@@ -863,6 +1087,9 @@ func (c *Client) newRequest(ctx context.Context, method string, metadata request
 	if err != nil {
 		return nil, err
 	}
+	if metadata.node != nil {
+		targetURL.Host = c.endpoints.targetHost(targetURL.Host, c.endpointURL.Host, metadata.node)
+	}
 
 	if c.httpTrace != nil {
 		ctx = httptrace.WithClientTrace(ctx, c.httpTrace)
@@ -1024,6 +1251,22 @@ func (c *Client) setUserAgent(req *http.Request) {
 	}
 }
 
+// stripDefaultPort - strips port 80 and 443 so we won't send these ports
+// in Host header. The reason is that browsers and curl automatically
+// remove :80 and :443 with the generated presigned urls, then a
+// signature mismatch error.
+func stripDefaultPort(scheme, host string) string {
+	if h, p, err := net.SplitHostPort(host); err == nil {
+		if scheme == "http" && p == "80" || scheme == "https" && p == "443" {
+			host = h
+			if ip := net.ParseIP(h); ip != nil && ip.To4() == nil {
+				host = "[" + h + "]"
+			}
+		}
+	}
+	return host
+}
+
 // makeTargetURL make a new target url.
 func (c *Client) makeTargetURL(bucketName, objectName, bucketLocation string, isVirtualHostStyle bool, queryValues url.Values) (*url.URL, error) {
 	host := c.endpointURL.Host
@@ -1060,17 +1303,7 @@ func (c *Client) makeTargetURL(bucketName, objectName, bucketLocation string, is
 	// Save scheme.
 	scheme := c.endpointURL.Scheme
 
-	// Strip port 80 and 443 so we won't send these ports in Host header.
-	// The reason is that browsers and curl automatically remove :80 and :443
-	// with the generated presigned urls, then a signature mismatch error.
-	if h, p, err := net.SplitHostPort(host); err == nil {
-		if scheme == "http" && p == "80" || scheme == "https" && p == "443" {
-			host = h
-			if ip := net.ParseIP(h); ip != nil && ip.To4() == nil {
-				host = "[" + h + "]"
-			}
-		}
-	}
+	host = stripDefaultPort(scheme, host)
 
 	urlStr := scheme + "://" + host + "/"
 
@@ -0,0 +1,215 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http/httptrace"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrAttemptTimeout is the error of a request attempt aborted
+	// because no response headers arrived within Options.AttemptTimeout.
+	ErrAttemptTimeout = errors.New("request attempt timed out waiting for response headers")
+
+	// ErrBodyStalled is the error of a request attempt aborted because
+	// its request or response body did not move for
+	// Options.StallTimeout.
+	ErrBodyStalled = errors.New("request body stalled")
+)
+
+// attemptWatchdog - aborts a request attempt that does not receive
+// response headers within the attempt timeout or whose body does not
+// move for the stall timeout, all methods are no-ops on a nil
+// attemptWatchdog.
+type attemptWatchdog struct {
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+
+	attemptTimeout time.Duration
+	stallTimeout   time.Duration
+
+	mu       sync.Mutex
+	timer    *time.Timer
+	armed    bool
+	deadline time.Time
+	cause    error
+}
+
+// newAttemptWatchdog - returns the watchdog of one attempt of a request
+// made with ctx, nil if both timeouts are disabled. The attempt timeout
+// starts once the request is sent, see sent.
+func newAttemptWatchdog(ctx context.Context, attemptTimeout, stallTimeout time.Duration) *attemptWatchdog {
+	if attemptTimeout <= 0 && stallTimeout <= 0 {
+		return nil
+	}
+	w := &attemptWatchdog{
+		attemptTimeout: attemptTimeout,
+		stallTimeout:   stallTimeout,
+	}
+	w.ctx, w.cancel = context.WithCancelCause(ctx)
+	// The wait for response headers starts over once the request,
+	// including a possibly long upload, has been written.
+	w.ctx = httptrace.WithClientTrace(w.ctx, &httptrace.ClientTrace{
+		WroteRequest: func(httptrace.WroteRequestInfo) {
+			w.arm(w.attemptTimeout, ErrAttemptTimeout)
+		},
+	})
+	w.timer = time.AfterFunc(time.Hour, w.expire)
+	w.timer.Stop()
+	return w
+}
+
+// sent - starts the attempt timeout as the request is handed to the
+// transport, the time spent building it, e.g. refreshing credentials
+// or looking up the bucket location, does not count.
+func (w *attemptWatchdog) sent() {
+	if w == nil {
+		return
+	}
+	w.arm(w.attemptTimeout, ErrAttemptTimeout)
+}
+
+// context - returns the context of the attempt, ctx itself if w is nil.
+func (w *attemptWatchdog) context(ctx context.Context) context.Context {
+	if w == nil {
+		return ctx
+	}
+	return w.ctx
+}
+
+// arm - aborts the attempt with cause unless re-armed or disarmed
+// within d, disarms it if d is not positive.
+func (w *attemptWatchdog) arm(d time.Duration, cause error) {
+	if w == nil {
+		return
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if d <= 0 {
+		w.armed = false
+		w.timer.Stop()
+		return
+	}
+	w.armed = true
+	w.deadline = time.Now().Add(d)
+	w.cause = cause
+	w.timer.Reset(d)
+}
+
+// disarm - stops the running timeout.
+func (w *attemptWatchdog) disarm() {
+	w.arm(0, nil)
+}
+
+// expire - aborts the attempt unless the timer was re-armed or
+// disarmed after it fired.
+func (w *attemptWatchdog) expire() {
+	w.mu.Lock()
+	if !w.armed || time.Now().Before(w.deadline) {
+		w.mu.Unlock()
+		return
+	}
+	w.armed = false
+	cause := w.cause
+	w.mu.Unlock()
+	w.cancel(cause)
+}
+
+// err - returns ErrAttemptTimeout or ErrBodyStalled if the watchdog
+// aborted the attempt, nil otherwise.
+func (w *attemptWatchdog) err() error {
+	if w == nil {
+		return nil
+	}
+	if cause := context.Cause(w.ctx); errors.Is(cause, ErrAttemptTimeout) || errors.Is(cause, ErrBodyStalled) {
+		return cause
+	}
+	return nil
+}
+
+// stop - releases the attempt.
+func (w *attemptWatchdog) stop() {
+	if w == nil {
+		return
+	}
+	w.disarm()
+	w.cancel(nil)
+}
+
+// uploadReader - returns body aborting the attempt if the transport
+// does not read from it for the stall timeout.
+func (w *attemptWatchdog) uploadReader(body io.Reader) io.Reader {
+	if w == nil || w.stallTimeout <= 0 || body == nil {
+		return body
+	}
+	return &stallUploadReader{w: w, source: body}
+}
+
+// responseBody - returns body aborting the attempt if a read does not
+// return any data for the stall timeout, closing it releases the
+// attempt.
+func (w *attemptWatchdog) responseBody(body io.ReadCloser) io.ReadCloser {
+	if w == nil {
+		return body
+	}
+	return &stallResponseBody{w: w, ReadCloser: body}
+}
+
+type stallUploadReader struct {
+	w      *attemptWatchdog
+	source io.Reader
+}
+
+func (r *stallUploadReader) Read(p []byte) (int, error) {
+	// Slow sources, e.g. throttled ones, are not stalls of the
+	// connection.
+	r.w.disarm()
+	n, err := r.source.Read(p)
+	if err == nil {
+		r.w.arm(r.w.stallTimeout, ErrBodyStalled)
+	}
+	return n, err
+}
+
+type stallResponseBody struct {
+	w *attemptWatchdog
+	io.ReadCloser
+}
+
+func (b *stallResponseBody) Read(p []byte) (int, error) {
+	b.w.arm(b.w.stallTimeout, ErrBodyStalled)
+	n, err := b.ReadCloser.Read(p)
+	b.w.disarm()
+	if err != nil && err != io.EOF {
+		if cause := b.w.err(); cause != nil {
+			err = cause
+		}
+	}
+	return n, err
+}
+
+func (b *stallResponseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.w.stop()
+	return err
+}
@@ -0,0 +1,161 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestAttemptTimeout(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The first attempt hangs.
+		if requests.Add(1) == 1 {
+			select {
+			case <-r.Context().Done():
+				return
+			case <-time.After(5 * time.Second):
+			}
+		}
+		w.Header().Set("ETag", "\"etag\"")
+		w.Header().Set("Last-Modified", time.Now().UTC().Format(http.TimeFormat))
+	}))
+	defer srv.Close()
+
+	clnt, err := New(srv.Listener.Addr().String(), &Options{
+		Region:         "us-east-1",
+		AttemptTimeout: 50 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	if _, err = clnt.StatObject(context.Background(), "bucket", "object", StatObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Fatalf("Expected the hanging attempt to be retried, took %v", elapsed)
+	}
+	if n := requests.Load(); n != 2 {
+		t.Fatalf("Expected 2 attempts, got %d", n)
+	}
+}
+
+// slowProvider - returns static credentials after a delay, like an STS
+// or IMDS call would.
+type slowProvider struct {
+	credentials.Static
+	delay time.Duration
+}
+
+func (p *slowProvider) RetrieveWithCredContext(cc *credentials.CredContext) (credentials.Value, error) {
+	time.Sleep(p.delay)
+	return p.Static.RetrieveWithCredContext(cc)
+}
+
+func (p *slowProvider) Retrieve() (credentials.Value, error) {
+	return p.RetrieveWithCredContext(nil)
+}
+
+func (p *slowProvider) IsExpired() bool {
+	return true
+}
+
+func TestAttemptTimeoutExcludesCredentials(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("ETag", "\"etag\"")
+		w.Header().Set("Last-Modified", time.Now().UTC().Format(http.TimeFormat))
+	}))
+	defer srv.Close()
+
+	// Retrieving the credentials takes longer than the attempt timeout.
+	provider := &slowProvider{delay: 100 * time.Millisecond}
+	provider.Value = credentials.Value{AccessKeyID: "accesskey", SecretAccessKey: "secretkey", SignerType: credentials.SignatureV4}
+	clnt, err := New(srv.Listener.Addr().String(), &Options{
+		Region:         "us-east-1",
+		Creds:          credentials.New(provider),
+		AttemptTimeout: 50 * time.Millisecond,
+		MaxRetries:     1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = clnt.StatObject(context.Background(), "bucket", "object", StatObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if n := requests.Load(); n != 1 {
+		t.Fatalf("Expected 1 attempt, got %d", n)
+	}
+}
+
+func TestStallTimeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", "\"etag\"")
+		w.Header().Set("Last-Modified", time.Now().UTC().Format(http.TimeFormat))
+		w.Header().Set("Content-Length", "10")
+		w.Write([]byte("hello"))
+		w.(http.Flusher).Flush()
+		// The rest of the body never arrives.
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	defer srv.Close()
+
+	clnt, err := New(srv.Listener.Addr().String(), &Options{
+		Region:       "us-east-1",
+		StallTimeout: 50 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	obj, _, _, err := clnt.getObject(context.Background(), "bucket", "object", GetObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Close()
+
+	// A consumer not reading is no stall.
+	time.Sleep(100 * time.Millisecond)
+
+	start := time.Now()
+	got, err := io.ReadAll(obj)
+	if !errors.Is(err, ErrBodyStalled) {
+		t.Fatalf("Expected ErrBodyStalled, got %v", err)
+	}
+	if string(got) != "hello" {
+		t.Fatalf("Unexpected content %q", got)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Fatalf("Expected the stalled body to be aborted, took %v", elapsed)
+	}
+}
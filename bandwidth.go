@@ -0,0 +1,159 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+)
+
+// BandwidthLimit caps the throughput of a client in bytes per second,
+// a zero value means unlimited.
+type BandwidthLimit struct {
+	Upload   int64
+	Download int64
+}
+
+// bandwidthLimiter is a token bucket shared by all the requests
+// throttled by it. The bucket holds at most one second worth of bytes.
+type bandwidthLimiter struct {
+	rate int64 // bytes per second
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// newBandwidthLimiter - returns a limiter for rate bytes per second,
+// nil if rate is not positive.
+func newBandwidthLimiter(rate int64) *bandwidthLimiter {
+	if rate <= 0 {
+		return nil
+	}
+	return &bandwidthLimiter{
+		rate:   rate,
+		tokens: float64(rate),
+		last:   time.Now(),
+	}
+}
+
+// wait - accounts for n transferred bytes, blocking until the rate
+// allows them. Bytes are always accounted, concurrent callers queue
+// up behind each other.
+func (l *bandwidthLimiter) wait(ctx context.Context, n int) error {
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens = min(l.tokens+now.Sub(l.last).Seconds()*float64(l.rate), float64(l.rate))
+	l.last = now
+	l.tokens -= float64(n)
+	delay := time.Duration(-l.tokens / float64(l.rate) * float64(time.Second))
+	l.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// throttledReader - throttles reads from source with limiter.
+type throttledReader struct {
+	ctx     context.Context
+	source  io.Reader
+	limiter *bandwidthLimiter
+}
+
+// Read implements io.Reader, reads at most one second worth of bytes
+// at a time to keep the throughput smooth.
+func (r *throttledReader) Read(b []byte) (int, error) {
+	if int64(len(b)) > r.limiter.rate {
+		b = b[:r.limiter.rate]
+	}
+	n, err := r.source.Read(b)
+	if n > 0 {
+		if werr := r.limiter.wait(r.ctx, n); werr != nil {
+			return n, werr
+		}
+	}
+	return n, err
+}
+
+// throttledReadCloser - throttles a response body.
+type throttledReadCloser struct {
+	throttledReader
+	io.Closer
+}
+
+// newThrottledReader - returns source throttled by limiter, source
+// itself if limiter is nil.
+func newThrottledReader(ctx context.Context, source io.Reader, limiter *bandwidthLimiter) io.Reader {
+	if limiter == nil || source == nil {
+		return source
+	}
+	return &throttledReader{ctx: ctx, source: source, limiter: limiter}
+}
+
+// newThrottledReadCloser - returns body throttled by limiter, body
+// itself if limiter is nil.
+func newThrottledReadCloser(ctx context.Context, body io.ReadCloser, limiter *bandwidthLimiter) io.ReadCloser {
+	if limiter == nil || body == nil {
+		return body
+	}
+	return &throttledReadCloser{
+		throttledReader: throttledReader{ctx: ctx, source: body, limiter: limiter},
+		Closer:          body,
+	}
+}
+
+type bandwidthContextKey struct{ upload bool }
+
+// withBandwidthLimit - returns a context throttling all the requests
+// made with it to rate bytes per second instead of the client wide
+// limit. Contexts which already carry a limit are returned as is, so
+// that all the requests of a single call share one limit.
+func withBandwidthLimit(ctx context.Context, upload bool, rate int64) context.Context {
+	if rate <= 0 {
+		return ctx
+	}
+	key := bandwidthContextKey{upload: upload}
+	if _, ok := ctx.Value(key).(*bandwidthLimiter); ok {
+		return ctx
+	}
+	return context.WithValue(ctx, key, newBandwidthLimiter(rate))
+}
+
+// bandwidthLimiters - returns the upload and download limiters that
+// apply to a request made with ctx.
+func (c *Client) bandwidthLimiters(ctx context.Context) (upload, download *bandwidthLimiter) {
+	upload, download = c.uploadLimiter, c.downloadLimiter
+	if l, ok := ctx.Value(bandwidthContextKey{upload: true}).(*bandwidthLimiter); ok {
+		upload = l
+	}
+	if l, ok := ctx.Value(bandwidthContextKey{upload: false}).(*bandwidthLimiter); ok {
+		download = l
+	}
+	return upload, download
+}
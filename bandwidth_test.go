@@ -0,0 +1,98 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestBandwidthLimiter(t *testing.T) {
+	l := newBandwidthLimiter(10000)
+
+	// A full bucket lets the first second worth of bytes through.
+	start := time.Now()
+	if err := l.wait(context.Background(), 10000); err != nil {
+		t.Fatal(err)
+	}
+	if d := time.Since(start); d > 50*time.Millisecond {
+		t.Fatalf("Expected no delay, got %v", d)
+	}
+
+	start = time.Now()
+	if err := l.wait(context.Background(), 2000); err != nil {
+		t.Fatal(err)
+	}
+	if d := time.Since(start); d < 150*time.Millisecond {
+		t.Fatalf("Expected about 200ms delay, got %v", d)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := l.wait(ctx, 10000); err != context.Canceled {
+		t.Fatalf("Expected %v, got %v", context.Canceled, err)
+	}
+
+	if newBandwidthLimiter(0) != nil {
+		t.Fatal("Expected no limiter for zero rate")
+	}
+}
+
+func TestBandwidthLimitDownload(t *testing.T) {
+	data := make([]byte, 15000)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", "\"etag\"")
+		http.ServeContent(w, r, "", time.Now(), bytes.NewReader(data))
+	}))
+	defer srv.Close()
+
+	clnt, err := New(srv.Listener.Addr().String(), &Options{
+		Region:         "us-east-1",
+		BandwidthLimit: BandwidthLimit{Download: 10000},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	download := func(opts GetObjectOptions) time.Duration {
+		start := time.Now()
+		reader, _, _, err := clnt.getObject(context.Background(), "bucket", "object", opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer reader.Close()
+		if _, err = io.Copy(io.Discard, reader); err != nil {
+			t.Fatal(err)
+		}
+		return time.Since(start)
+	}
+
+	// 5000 bytes over the initial burst of the client wide limit.
+	if d := download(GetObjectOptions{}); d < 400*time.Millisecond {
+		t.Fatalf("Expected client limit to throttle the download, took %v", d)
+	}
+	// A higher per call limit overrides the client wide limit.
+	if d := download(GetObjectOptions{BandwidthLimit: 1 << 20}); d > 300*time.Millisecond {
+		t.Fatalf("Expected per call limit to override the client limit, took %v", d)
+	}
+}
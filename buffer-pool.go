@@ -0,0 +1,154 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"sync"
+)
+
+// bufferPool - hands out upload buffers while keeping the memory held
+// by all of them, in use or cached for reuse, below limit.
+type bufferPool struct {
+	limit int64
+
+	mu   sync.Mutex
+	used int64
+	free map[int][][]byte
+	// Closed and replaced whenever a buffer is returned.
+	freed chan struct{}
+}
+
+// newBufferPool - returns a pool of at most limit bytes, nil if limit
+// is not positive.
+func newBufferPool(limit int64) *bufferPool {
+	if limit <= 0 {
+		return nil
+	}
+	return &bufferPool{
+		limit: limit,
+		free:  make(map[int][][]byte),
+		freed: make(chan struct{}),
+	}
+}
+
+// get - returns a buffer of size bytes, waiting for other buffers to
+// be returned when the limit is reached. A buffer larger than the
+// limit is only handed out when no other buffer is held.
+func (p *bufferPool) get(ctx context.Context, size int) ([]byte, error) {
+	for {
+		p.mu.Lock()
+		if bufs := p.free[size]; len(bufs) > 0 {
+			buf := bufs[len(bufs)-1]
+			p.free[size] = bufs[:len(bufs)-1]
+			p.mu.Unlock()
+			return buf, nil
+		}
+		if p.used+int64(size) > p.limit {
+			// Make room by dropping cached buffers of other sizes.
+			for n, bufs := range p.free {
+				p.used -= int64(n * len(bufs))
+				delete(p.free, n)
+			}
+		}
+		if p.used+int64(size) <= p.limit || p.used == 0 {
+			p.used += int64(size)
+			p.mu.Unlock()
+			return make([]byte, size), nil
+		}
+		freed := p.freed
+		p.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-freed:
+		}
+	}
+}
+
+// put - returns buf to the pool for reuse.
+func (p *bufferPool) put(buf []byte) {
+	buf = buf[:cap(buf)]
+	p.mu.Lock()
+	p.free[len(buf)] = append(p.free[len(buf)], buf)
+	close(p.freed)
+	p.freed = make(chan struct{})
+	p.mu.Unlock()
+}
+
+// partBuffers - the part buffers of a single upload, at most n of
+// them are handed out at any time. Without a memory budget the
+// buffers belong to the upload, with a budget they are borrowed from
+// the client wide pool for every part.
+type partBuffers struct {
+	pool *bufferPool
+	size int
+
+	// Free buffers without a pool, slots with a pool.
+	free  chan []byte
+	slots chan struct{}
+}
+
+// newPartBuffers - returns n buffers of size bytes for a single upload.
+func (c *Client) newPartBuffers(n int, size int64) *partBuffers {
+	b := &partBuffers{pool: c.uploadBuffers, size: int(size)}
+	if b.pool != nil {
+		b.slots = make(chan struct{}, n)
+		return b
+	}
+	b.free = make(chan []byte, n)
+	all := make([]byte, int64(n)*size)
+	for i := range n {
+		b.free <- all[i*b.size : (i+1)*b.size]
+	}
+	return b
+}
+
+// get - returns a free buffer, waiting for one if needed.
+func (b *partBuffers) get(ctx context.Context) ([]byte, error) {
+	if b.pool == nil {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case buf := <-b.free:
+			return buf, nil
+		}
+	}
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case b.slots <- struct{}{}:
+	}
+	buf, err := b.pool.get(ctx, b.size)
+	if err != nil {
+		<-b.slots
+		return nil, err
+	}
+	return buf, nil
+}
+
+// put - returns a buffer obtained from get.
+func (b *partBuffers) put(buf []byte) {
+	if b.pool == nil {
+		b.free <- buf
+		return
+	}
+	b.pool.put(buf)
+	<-b.slots
+}
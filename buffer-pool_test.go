@@ -0,0 +1,160 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestBufferPool(t *testing.T) {
+	p := newBufferPool(10)
+	ctx := context.Background()
+
+	a, err := p.get(ctx, 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The second buffer has to wait for the first one.
+	got := make(chan []byte)
+	go func() {
+		b, _ := p.get(ctx, 6)
+		got <- b
+	}()
+	select {
+	case <-got:
+		t.Fatal("Expected get to wait for the memory budget")
+	case <-time.After(50 * time.Millisecond):
+	}
+	p.put(a)
+	select {
+	case b := <-got:
+		if &b[0] != &a[0] {
+			t.Fatal("Expected the returned buffer to be reused")
+		}
+		p.put(b)
+	case <-time.After(time.Second):
+		t.Fatal("Expected get to succeed once memory is returned")
+	}
+
+	// Cached buffers of other sizes are dropped to make room, a
+	// buffer larger than the budget is handed out when nothing is held.
+	big, err := p.get(ctx, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err = p.get(cctx, 1); err != context.Canceled {
+		t.Fatalf("Expected %v, got %v", context.Canceled, err)
+	}
+	p.put(big)
+}
+
+func TestOptimalPartInfoBudget(t *testing.T) {
+	clnt, err := New("localhost:9000", &Options{MaxUploadBufferMemory: 64 << 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Four buffers fit the budget with 15MiB parts, uploads of unknown
+	// size are then limited to 10000 of them.
+	for _, size := range []int64{-1, 1 << 30} {
+		_, partSize, _, err := clnt.optimalPartInfo(size, 0, 4)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if partSize != 15<<20 {
+			t.Fatalf("Expected 15MiB parts for size %d, got %d", size, partSize)
+		}
+	}
+
+	// A small budget gives minimum sized parts, which fit it and so wait
+	// for buffers.
+	small, err := New("localhost:9000", &Options{MaxUploadBufferMemory: absMinPartSize + 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, partSize, _, err := small.optimalPartInfo(-1, 0, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if partSize != absMinPartSize {
+		t.Fatalf("Expected %d bytes parts, got %d", absMinPartSize, partSize)
+	}
+
+	// Budgets smaller than a part are rejected.
+	if _, err = New("localhost:9000", &Options{MaxUploadBufferMemory: absMinPartSize - 1}); ToErrorResponse(err).Code != InvalidArgument {
+		t.Fatalf("Expected %s, got %v", InvalidArgument, err)
+	}
+
+	// Large objects still need to fit in 10000 parts.
+	_, partSize, _, err = clnt.optimalPartInfo(1<<40, 0, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if required := int64(1<<40) / maxPartsCount; partSize < required {
+		t.Fatalf("Expected parts of at least %d, got %d", required, partSize)
+	}
+
+	// Configured part sizes are kept.
+	if _, partSize, _, _ = clnt.optimalPartInfo(-1, 64<<20, 4); partSize != 64<<20 {
+		t.Fatalf("Expected configured part size, got %d", partSize)
+	}
+}
+
+func TestPutObjectParallelBudget(t *testing.T) {
+	data := make([]byte, 5*absMinPartSize+17)
+	rand.Read(data)
+
+	// Only two of the four parts in flight fit the budget, whether the
+	// part size is configured or chosen from the budget.
+	for _, partSize := range []uint64{absMinPartSize, 0} {
+		mts := &multipartTestServer{}
+		srv := httptest.NewServer(mts)
+		defer srv.Close()
+
+		clnt, err := New(srv.Listener.Addr().String(), &Options{
+			Region:                "us-east-1",
+			MaxUploadBufferMemory: 2 * absMinPartSize,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = clnt.PutObject(context.Background(), "bucket", "object", bytes.NewReader(data), -1, PutObjectOptions{
+			PartSize:              partSize,
+			NumThreads:            4,
+			ConcurrentStreamParts: true,
+			DisableContentSha256:  true,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(mts.object, data) {
+			t.Fatal("Uploaded content does not match")
+		}
+		if used := clnt.uploadBuffers.used; used > 2*absMinPartSize {
+			t.Fatalf("Expected at most %d bytes of buffers, got %d", 2*absMinPartSize, used)
+		}
+	}
+}
@@ -0,0 +1,181 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// Consecutive network errors taking a node out of rotation.
+	nodeFailureThreshold = 3
+
+	// Time a failed node is out of rotation unless the health check
+	// finds it online earlier.
+	nodeOfflineDuration = 30 * time.Second
+)
+
+// endpointNode - a single node of a multi-endpoint client with its
+// circuit breaker state.
+type endpointNode struct {
+	host string
+
+	mu       sync.Mutex
+	failures int
+	// The node is out of rotation until then.
+	offlineUntil time.Time
+}
+
+// available - returns true if the node is in rotation.
+func (n *endpointNode) available(now time.Time) bool {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return !now.Before(n.offlineUntil)
+}
+
+// markOnline - puts the node back into rotation.
+func (n *endpointNode) markOnline() {
+	n.mu.Lock()
+	n.failures = 0
+	n.offlineUntil = time.Time{}
+	n.mu.Unlock()
+}
+
+// markFailed - records a network error, taking the node out of rotation
+// after nodeFailureThreshold consecutive ones.
+func (n *endpointNode) markFailed() {
+	n.mu.Lock()
+	n.failures++
+	if n.failures >= nodeFailureThreshold {
+		n.offlineUntil = time.Now().Add(nodeOfflineDuration)
+	}
+	n.mu.Unlock()
+}
+
+// endpointPool - the nodes of a multi-endpoint client, requests are
+// spread over the nodes in rotation. All methods are no-ops on a nil
+// endpointPool.
+type endpointPool struct {
+	scheme string
+	nodes  []*endpointNode
+	next   atomic.Uint64
+}
+
+// newEndpointPool - returns the pool of endpoint and the additional
+// endpoints, nil if there are none.
+func newEndpointPool(endpointURL *url.URL, endpoints []string) (*endpointPool, error) {
+	if len(endpoints) == 0 {
+		return nil, nil
+	}
+	p := &endpointPool{
+		scheme: endpointURL.Scheme,
+		nodes:  []*endpointNode{{host: endpointURL.Host}},
+	}
+	for _, endpoint := range endpoints {
+		u, err := getEndpointURL(endpoint, endpointURL.Scheme == "https")
+		if err != nil {
+			return nil, err
+		}
+		if u.Host != endpointURL.Host {
+			p.nodes = append(p.nodes, &endpointNode{host: u.Host})
+		}
+	}
+	return p, nil
+}
+
+// pick - returns the next node in rotation, preferring another one than
+// failed. If no node is in rotation all of them are tried in turn.
+func (p *endpointPool) pick(failed *endpointNode) *endpointNode {
+	if p == nil {
+		return nil
+	}
+	now := time.Now()
+	start := p.next.Add(1)
+	var fallback *endpointNode
+	for i := range uint64(len(p.nodes)) {
+		n := p.nodes[(start+i)%uint64(len(p.nodes))]
+		if !n.available(now) {
+			continue
+		}
+		if n != failed {
+			return n
+		}
+		fallback = n
+	}
+	if fallback != nil {
+		return fallback
+	}
+	return p.nodes[start%uint64(len(p.nodes))]
+}
+
+// targetHost - returns host, a host of the primary endpoint, rewritten
+// to address node n.
+func (p *endpointPool) targetHost(host, primary string, n *endpointNode) string {
+	primary = stripDefaultPort(p.scheme, primary)
+	nodeHost := stripDefaultPort(p.scheme, n.host)
+	switch {
+	case host == primary:
+		return nodeHost
+	case strings.HasSuffix(host, "."+primary):
+		// Virtual host style request.
+		return strings.TrimSuffix(host, primary) + nodeHost
+	}
+	return host
+}
+
+// probeNodes - puts the nodes out of rotation back once they respond to
+// a request for probeBucketName.
+func (c *Client) probeNodes(ctx context.Context, probeBucketName string) {
+	now := time.Now()
+	for _, n := range c.endpoints.nodes {
+		if n.available(now) {
+			continue
+		}
+		gctx, gcancel := context.WithTimeout(ctx, 3*time.Second)
+		req, err := c.newRequest(gctx, http.MethodGet, requestMetadata{
+			bucketName:     probeBucketName,
+			bucketLocation: getDefaultLocation(*c.endpointURL, c.region),
+			queryValues:    url.Values{"location": {""}},
+			node:           n,
+		})
+		if err == nil {
+			var resp *http.Response
+			if resp, err = c.httpClient.Do(req); err == nil {
+				closeResponse(resp)
+				n.markOnline()
+			}
+		}
+		gcancel()
+	}
+}
+
+// isIdempotent - returns true if requests of method can be retried on
+// another node.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
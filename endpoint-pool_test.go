@@ -0,0 +1,89 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestEndpointFailover(t *testing.T) {
+	var hits [2]atomic.Int32
+	var srvs [2]*httptest.Server
+	for i := range srvs {
+		srvs[i] = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			hits[i].Add(1)
+			w.Header().Set("ETag", "\"etag\"")
+			w.Header().Set("Last-Modified", time.Now().UTC().Format(http.TimeFormat))
+		}))
+		defer srvs[i].Close()
+	}
+
+	// A node refusing connections.
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dead := l.Addr().String()
+	l.Close()
+
+	clnt, err := New(srvs[0].Listener.Addr().String(), &Options{
+		Region:      "us-east-1",
+		Endpoints:   []string{dead, srvs[1].Listener.Addr().String()},
+		RetryPolicy: DefaultRetryPolicy{BaseDelay: time.Millisecond},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for range 30 {
+		if _, err = clnt.StatObject(context.Background(), "bucket", "object", StatObjectOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if hits[0].Load() == 0 || hits[1].Load() == 0 {
+		t.Fatalf("Expected requests to be spread over the nodes, got %d and %d", hits[0].Load(), hits[1].Load())
+	}
+
+	// The dead node is out of rotation, the live ones are not.
+	now := time.Now()
+	for _, n := range clnt.endpoints.nodes {
+		if available := n.available(now); available != (n.host != dead) {
+			t.Fatalf("Unexpected availability %v of node %s", available, n.host)
+		}
+	}
+}
+
+func TestEndpointTargetHost(t *testing.T) {
+	p := &endpointPool{scheme: "http"}
+	node := &endpointNode{host: "node2:80"}
+	for host, want := range map[string]string{
+		"node1":        "node2",
+		"bucket.node1": "bucket.node2",
+		"other":        "other",
+	} {
+		if got := p.targetHost(host, "node1:80", node); got != want {
+			t.Errorf("Expected %s, got %s", want, got)
+		}
+	}
+}
@@ -0,0 +1,237 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// HedgePolicy enables hedged GET and HEAD requests. If a request has
+// not responded within the hedge delay a duplicate is sent, the first
+// response is used and the other request is canceled. The zero value
+// disables hedging.
+type HedgePolicy struct {
+	// Delay after which the duplicate request is sent.
+	Delay time.Duration
+
+	// Percentile, if set, e.g. 0.95, uses this percentile of the recent
+	// response times of the client as delay instead. Delay is used
+	// until enough requests have been observed.
+	Percentile float64
+}
+
+// enabled - returns true if requests are hedged.
+func (p HedgePolicy) enabled() bool {
+	return p.Delay > 0 || p.Percentile > 0
+}
+
+// HedgeStats counts the hedged requests of a client.
+type HedgeStats struct {
+	// Requests eligible for hedging.
+	Requests uint64
+	// Duplicate requests sent.
+	Hedged uint64
+	// Duplicate requests answering before the original one.
+	Wins uint64
+}
+
+const (
+	// Number of recent response times kept for percentiles.
+	hedgeSamples = 128
+	// Response times required before percentiles are used.
+	hedgeMinSamples = 16
+)
+
+// hedgeState - hedging counters and the recent response times of the
+// GET and HEAD requests of a client.
+type hedgeState struct {
+	requests atomic.Uint64
+	hedged   atomic.Uint64
+	wins     atomic.Uint64
+
+	mu      sync.Mutex
+	samples []time.Duration
+	next    int
+}
+
+// observe - records the response time of a request, or how long it
+// ran until canceled by a hedge answering first.
+func (h *hedgeState) observe(d time.Duration) {
+	h.mu.Lock()
+	if len(h.samples) < hedgeSamples {
+		h.samples = append(h.samples, d)
+	} else {
+		h.samples[h.next] = d
+		h.next = (h.next + 1) % hedgeSamples
+	}
+	h.mu.Unlock()
+}
+
+// delay - returns the hedge delay of policy.
+func (h *hedgeState) delay(policy HedgePolicy) time.Duration {
+	if policy.Percentile <= 0 {
+		return policy.Delay
+	}
+	h.mu.Lock()
+	if len(h.samples) < hedgeMinSamples {
+		h.mu.Unlock()
+		return policy.Delay
+	}
+	samples := slices.Clone(h.samples)
+	h.mu.Unlock()
+
+	slices.Sort(samples)
+	i := int(float64(len(samples)) * min(policy.Percentile, 1))
+	return samples[min(i, len(samples)-1)]
+}
+
+type hedgePolicyContextKey struct{}
+
+// WithHedgePolicy returns a context overriding the hedge policy of the
+// client for all requests made with it, a zero policy disables
+// hedging.
+func WithHedgePolicy(ctx context.Context, policy HedgePolicy) context.Context {
+	return context.WithValue(ctx, hedgePolicyContextKey{}, policy)
+}
+
+// hedgePolicy - returns the hedge policy of requests made with ctx.
+func (c *Client) hedgePolicy(ctx context.Context) HedgePolicy {
+	if policy, ok := ctx.Value(hedgePolicyContextKey{}).(HedgePolicy); ok {
+		return policy
+	}
+	return c.defaultHedgePolicy
+}
+
+// HedgeStats returns the hedging counters of the client.
+func (c *Client) HedgeStats() HedgeStats {
+	return HedgeStats{
+		Requests: c.hedge.requests.Load(),
+		Hedged:   c.hedge.hedged.Load(),
+		Wins:     c.hedge.wins.Load(),
+	}
+}
+
+// cancelOnClose - cancels the context of a request once its response
+// body is closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// doHedged - executes a GET or HEAD request, sending a duplicate once
+// the hedge delay of policy passed without a response. The first
+// response wins, a transport error only if both requests failed.
+func (c *Client) doHedged(req *http.Request, policy HedgePolicy) (*http.Response, error) {
+	type result struct {
+		res   *http.Response
+		err   error
+		hedge bool
+		start time.Time
+	}
+	results := make(chan result, 2)
+	var cancels []context.CancelFunc
+	var primaryStart time.Time
+	primaryPending := true
+	send := func(hedge bool) {
+		ctx, cancel := context.WithCancel(req.Context())
+		cancels = append(cancels, cancel)
+		r := req.Clone(ctx)
+		start := time.Now()
+		if !hedge {
+			primaryStart = start
+		}
+		go func() {
+			res, err := c.do(r)
+			results <- result{res: res, err: err, hedge: hedge, start: start}
+		}()
+	}
+
+	c.hedge.requests.Add(1)
+	send(false)
+	timer := time.NewTimer(c.hedge.delay(policy))
+	defer timer.Stop()
+
+	pending := 1
+	for {
+		select {
+		case <-timer.C:
+			c.hedge.hedged.Add(1)
+			send(true)
+			pending++
+		case r := <-results:
+			pending--
+			if !r.hedge {
+				primaryPending = false
+			}
+			if r.err != nil && pending > 0 {
+				// Wait for the other request.
+				continue
+			}
+			if r.err == nil {
+				if r.hedge && primaryPending {
+					// The primary request is at least as slow as the time
+					// it ran, recording only the winning hedges would
+					// lower the percentile delay over time.
+					c.hedge.observe(time.Since(primaryStart))
+				} else {
+					c.hedge.observe(time.Since(r.start))
+				}
+				if r.hedge {
+					c.hedge.wins.Add(1)
+				}
+			}
+
+			// Cancel the other request, its context is the one not
+			// belonging to this result.
+			winner := 0
+			if r.hedge {
+				winner = 1
+			}
+			for i, cancel := range cancels {
+				if i != winner {
+					cancel()
+				}
+			}
+			if pending > 0 {
+				go func() {
+					if other := <-results; other.res != nil {
+						closeResponse(other.res)
+					}
+				}()
+			}
+			if r.err != nil {
+				cancels[winner]()
+				return nil, r.err
+			}
+			r.res.Body = cancelOnClose{ReadCloser: r.res.Body, cancel: cancels[winner]}
+			return r.res, nil
+		}
+	}
+}
@@ -0,0 +1,143 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestHedgedRequests(t *testing.T) {
+	data := []byte("hedged content")
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Every other request is slow.
+		if requests.Add(1)%2 == 1 {
+			select {
+			case <-r.Context().Done():
+				return
+			case <-time.After(time.Second):
+			}
+		}
+		w.Header().Set("ETag", "\"etag\"")
+		w.Header().Set("Last-Modified", time.Now().UTC().Format(http.TimeFormat))
+		w.Write(data)
+	}))
+	defer srv.Close()
+
+	clnt, err := New(srv.Listener.Addr().String(), &Options{
+		Region:      "us-east-1",
+		HedgePolicy: HedgePolicy{Delay: 20 * time.Millisecond},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	if _, err = clnt.StatObject(context.Background(), "bucket", "object", StatObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	obj, err := clnt.GetObject(context.Background(), "bucket", "object", GetObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := io.ReadAll(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	obj.Close()
+	if !bytes.Equal(got, data) {
+		t.Fatal("Read content does not match")
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Fatalf("Expected hedges to answer, took %v", elapsed)
+	}
+	if stats := clnt.HedgeStats(); stats.Requests != 2 || stats.Hedged != 2 || stats.Wins != 2 {
+		t.Fatalf("Unexpected hedge stats %+v", stats)
+	}
+
+	// Hedging is disabled per call.
+	requests.Store(0)
+	start = time.Now()
+	ctx := WithHedgePolicy(context.Background(), HedgePolicy{})
+	if _, err = clnt.StatObject(ctx, "bucket", "object", StatObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < 500*time.Millisecond {
+		t.Fatalf("Expected no hedge, took %v", elapsed)
+	}
+}
+
+func TestHedgeDelayPercentile(t *testing.T) {
+	var h hedgeState
+	policy := HedgePolicy{Delay: time.Second, Percentile: 0.9}
+	if d := h.delay(policy); d != time.Second {
+		t.Fatalf("Expected fixed delay without samples, got %v", d)
+	}
+	for i := range 100 {
+		h.observe(time.Duration(i+1) * time.Millisecond)
+	}
+	if d := h.delay(policy); d != 91*time.Millisecond {
+		t.Fatalf("Expected 91ms, got %v", d)
+	}
+}
+
+func TestHedgeDelayPercentileWins(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Every original request is slow, every hedge answers at once.
+		if requests.Add(1)%2 == 1 {
+			select {
+			case <-r.Context().Done():
+				return
+			case <-time.After(time.Second):
+			}
+		}
+		w.Header().Set("ETag", "\"etag\"")
+		w.Header().Set("Last-Modified", time.Now().UTC().Format(http.TimeFormat))
+	}))
+	defer srv.Close()
+
+	policy := HedgePolicy{Delay: 20 * time.Millisecond, Percentile: 0.5}
+	clnt, err := New(srv.Listener.Addr().String(), &Options{
+		Region:      "us-east-1",
+		HedgePolicy: policy,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for range 2 * hedgeMinSamples {
+		if _, err = clnt.StatObject(context.Background(), "bucket", "object", StatObjectOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if stats := clnt.HedgeStats(); stats.Wins != 2*hedgeMinSamples {
+		t.Fatalf("Unexpected hedge stats %+v", stats)
+	}
+	// The canceled requests keep the delay from falling to the response
+	// time of the hedges.
+	if d := clnt.hedge.delay(policy); d < policy.Delay || d > 500*time.Millisecond {
+		t.Fatalf("Expected a delay of about %v, got %v", policy.Delay, d)
+	}
+}
@@ -0,0 +1,350 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// RequestInfo describes an S3 request reported to RequestHooks.
+type RequestInfo struct {
+	// S3 operation name, e.g. "GetObject" or "UploadPart".
+	Operation  string
+	Method     string
+	BucketName string
+	ObjectName string
+
+	// Attempt is zero for the first request and counts the retries.
+	Attempt int
+
+	// Status code and S3 error code of the response, set on retry
+	// and end when a response was received.
+	StatusCode int
+	ErrorCode  string
+
+	// Bytes sent and received by all attempts so far.
+	BytesSent     int64
+	BytesReceived int64
+
+	// Time since the start of the request, zero on start.
+	Duration time.Duration
+
+	// Err is the error of the failed attempt on retry and the final
+	// error on end.
+	Err error
+}
+
+// RequestHooks observes the requests sent by a client, e.g. to trace
+// them. Every request is reported once on start and once on end, with
+// every retry in between. Successful requests end when the response
+// body is closed, so the duration and received bytes include reading
+// it. Hooks are called concurrently for concurrent requests.
+type RequestHooks interface {
+	// RequestStart is called before the first attempt, the returned
+	// context is used for the request and all its retries.
+	RequestStart(ctx context.Context, info RequestInfo) context.Context
+
+	// RequestRetry is called before a failed attempt is retried.
+	RequestRetry(ctx context.Context, info RequestInfo)
+
+	// RequestEnd is called once the request completed or failed.
+	RequestEnd(ctx context.Context, info RequestInfo)
+}
+
+// observedRequest - a request reported to the RequestHooks of the
+// client. All methods are no-ops on a nil observedRequest.
+type observedRequest struct {
+	hooks RequestHooks
+	ctx   context.Context
+	start time.Time
+
+	sent     atomic.Int64
+	received atomic.Int64
+
+	mu   sync.Mutex
+	info RequestInfo
+	done bool
+}
+
+// observeRequest - reports the start of a request to the hooks of the
+// client, returns nil if there are none. The body of metadata is
+// wrapped to count the bytes sent.
+func (c *Client) observeRequest(ctx context.Context, method string, metadata *requestMetadata) *observedRequest {
+	if c.hooks == nil {
+		return nil
+	}
+	o := &observedRequest{
+		hooks: c.hooks,
+		start: time.Now(),
+		info: RequestInfo{
+			Operation:  s3Operation(method, *metadata),
+			Method:     method,
+			BucketName: metadata.bucketName,
+			ObjectName: metadata.objectName,
+		},
+	}
+	o.ctx = o.hooks.RequestStart(ctx, o.info)
+	if o.ctx == nil {
+		o.ctx = ctx
+	}
+	if metadata.contentBody != nil {
+		metadata.contentBody = &countingReader{r: metadata.contentBody, n: &o.sent}
+	}
+	return o
+}
+
+// context - returns the context of the request.
+func (o *observedRequest) context(ctx context.Context) context.Context {
+	if o == nil {
+		return ctx
+	}
+	return o.ctx
+}
+
+// update - returns the current state of the request. The status and
+// error code are taken from err when it is an error response.
+func (o *observedRequest) update(attempt, status int, err error) RequestInfo {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.info.Attempt = attempt
+	o.info.Err = err
+	o.info.StatusCode, o.info.ErrorCode = status, ""
+	if errResp, ok := err.(ErrorResponse); ok {
+		o.info.StatusCode, o.info.ErrorCode = errResp.StatusCode, errResp.Code
+	}
+	o.info.BytesSent = o.sent.Load()
+	o.info.BytesReceived = o.received.Load()
+	o.info.Duration = time.Since(o.start)
+	return o.info
+}
+
+// retry - reports that attempt is a retry of the attempt that failed
+// with err.
+func (o *observedRequest) retry(attempt int, err error) {
+	if o == nil {
+		return
+	}
+	o.hooks.RequestRetry(o.ctx, o.update(attempt, 0, err))
+}
+
+// finish - reports the end of the request once executeMethod returns.
+// The end of successful requests is reported when the response body
+// is closed.
+func (o *observedRequest) finish(attempt int, res *http.Response, err error) {
+	if o == nil {
+		return
+	}
+	var status int
+	if res != nil {
+		status = res.StatusCode
+		if err == nil && res.Body != nil {
+			res.Body = &observedBody{ReadCloser: res.Body, o: o, attempt: attempt, status: status}
+			return
+		}
+	}
+	o.end(attempt, status, err)
+}
+
+// end - reports the end of the request once.
+func (o *observedRequest) end(attempt, status int, err error) {
+	o.mu.Lock()
+	done := o.done
+	o.done = true
+	o.mu.Unlock()
+	if !done {
+		o.hooks.RequestEnd(o.ctx, o.update(attempt, status, err))
+	}
+}
+
+// observedBody - counts the bytes of a response body and ends the
+// request when it is closed.
+type observedBody struct {
+	io.ReadCloser
+	o       *observedRequest
+	attempt int
+	status  int
+}
+
+func (b *observedBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.o.received.Add(int64(n))
+	return n, err
+}
+
+func (b *observedBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.o.end(b.attempt, b.status, nil)
+	return err
+}
+
+// countingReader - counts the bytes read from r.
+type countingReader struct {
+	r io.Reader
+	n *atomic.Int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.n.Add(int64(n))
+	return n, err
+}
+
+// Operation names of bucket and object sub-resources, prefixed with
+// Get, Put or Delete by the request method.
+var (
+	bucketSubResources = map[string]string{
+		"cors":         "BucketCors",
+		"encryption":   "BucketEncryption",
+		"lifecycle":    "BucketLifecycleConfiguration",
+		"location":     "BucketLocation",
+		"notification": "BucketNotificationConfiguration",
+		"object-lock":  "ObjectLockConfiguration",
+		"policy":       "BucketPolicy",
+		"replication":  "BucketReplication",
+		"tagging":      "BucketTagging",
+		"versioning":   "BucketVersioning",
+	}
+	objectSubResources = map[string]string{
+		"acl":        "ObjectAcl",
+		"attributes": "ObjectAttributes",
+		"legal-hold": "ObjectLegalHold",
+		"retention":  "ObjectRetention",
+		"tagging":    "ObjectTagging",
+	}
+	methodPrefix = map[string]string{
+		http.MethodGet:    "Get",
+		http.MethodPut:    "Put",
+		http.MethodDelete: "Delete",
+	}
+)
+
+// s3Operation - returns the S3 operation name of a request.
+func s3Operation(method string, metadata requestMetadata) string {
+	query := metadata.queryValues
+	has := func(key string) bool {
+		_, ok := query[key]
+		return ok
+	}
+	isCopy := metadata.customHeader.Get("X-Amz-Copy-Source") != ""
+
+	switch {
+	case metadata.bucketName == "":
+		return "ListBuckets"
+	case metadata.objectName == "":
+		for key, name := range bucketSubResources {
+			if has(key) && methodPrefix[method] != "" {
+				return methodPrefix[method] + name
+			}
+		}
+		switch {
+		case method == http.MethodPost && has("delete"):
+			return "DeleteObjects"
+		case method == http.MethodGet && has("uploads"):
+			return "ListMultipartUploads"
+		case method == http.MethodGet && has("versions"):
+			return "ListObjectVersions"
+		case method == http.MethodGet && query.Get("list-type") == "2":
+			return "ListObjectsV2"
+		}
+		switch method {
+		case http.MethodGet:
+			return "ListObjects"
+		case http.MethodHead:
+			return "HeadBucket"
+		case http.MethodPut:
+			return "CreateBucket"
+		case http.MethodDelete:
+			return "DeleteBucket"
+		}
+	default:
+		for key, name := range objectSubResources {
+			if has(key) && methodPrefix[method] != "" {
+				return methodPrefix[method] + name
+			}
+		}
+		switch {
+		case method == http.MethodPost && has("uploads"):
+			return "CreateMultipartUpload"
+		case method == http.MethodPost && has("restore"):
+			return "RestoreObject"
+		case method == http.MethodPost && has("select"):
+			return "SelectObjectContent"
+		case has("uploadId"):
+			switch method {
+			case http.MethodPut:
+				if isCopy {
+					return "UploadPartCopy"
+				}
+				return "UploadPart"
+			case http.MethodPost:
+				return "CompleteMultipartUpload"
+			case http.MethodDelete:
+				return "AbortMultipartUpload"
+			case http.MethodGet:
+				return "ListParts"
+			}
+		}
+		switch method {
+		case http.MethodGet:
+			return "GetObject"
+		case http.MethodHead:
+			return "HeadObject"
+		case http.MethodPut:
+			if isCopy {
+				return "CopyObject"
+			}
+			return "PutObject"
+		case http.MethodDelete:
+			return "DeleteObject"
+		}
+	}
+	return method
+}
+
+// multiHooks - notifies several hooks in order.
+type multiHooks []RequestHooks
+
+// JoinHooks returns hooks notifying all of hooks in order, e.g. to
+// both trace requests and collect their metrics.
+func JoinHooks(hooks ...RequestHooks) RequestHooks {
+	return multiHooks(hooks)
+}
+
+func (m multiHooks) RequestStart(ctx context.Context, info RequestInfo) context.Context {
+	for _, h := range m {
+		ctx = h.RequestStart(ctx, info)
+	}
+	return ctx
+}
+
+func (m multiHooks) RequestRetry(ctx context.Context, info RequestInfo) {
+	for _, h := range m {
+		h.RequestRetry(ctx, info)
+	}
+}
+
+func (m multiHooks) RequestEnd(ctx context.Context, info RequestInfo) {
+	for _, h := range m {
+		h.RequestEnd(ctx, info)
+	}
+}
@@ -0,0 +1,155 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type recordedHooks struct {
+	mu     sync.Mutex
+	events []string
+	ends   chan RequestInfo
+}
+
+func (h *recordedHooks) RequestStart(ctx context.Context, info RequestInfo) context.Context {
+	h.mu.Lock()
+	h.events = append(h.events, "start "+info.Operation)
+	h.mu.Unlock()
+	return ctx
+}
+
+func (h *recordedHooks) RequestRetry(_ context.Context, info RequestInfo) {
+	h.mu.Lock()
+	h.events = append(h.events, "retry "+info.ErrorCode)
+	h.mu.Unlock()
+}
+
+func (h *recordedHooks) RequestEnd(_ context.Context, info RequestInfo) {
+	h.mu.Lock()
+	h.events = append(h.events, "end "+info.Operation)
+	h.mu.Unlock()
+	h.ends <- info
+}
+
+func TestRequestHooks(t *testing.T) {
+	data := []byte("hello world")
+	var puts atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			if puts.Add(1) == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				w.Write([]byte(`<Error><Code>SlowDown</Code></Error>`))
+				return
+			}
+			io.Copy(io.Discard, r.Body)
+			w.Header().Set("ETag", "\"etag\"")
+		case http.MethodGet:
+			w.Header().Set("ETag", "\"etag\"")
+			w.Header().Set("Last-Modified", time.Now().UTC().Format(http.TimeFormat))
+			w.Write(data)
+		case http.MethodHead:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	hooks := &recordedHooks{ends: make(chan RequestInfo, 3)}
+	clnt, err := New(srv.Listener.Addr().String(), &Options{Region: "us-east-1", Hooks: hooks})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	_, err = clnt.PutObject(ctx, "bucket", "object", bytes.NewReader(data), int64(len(data)), PutObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = clnt.StatObject(ctx, "bucket", "missing", StatObjectOptions{}); err == nil {
+		t.Fatal("Expected StatObject to fail")
+	}
+	obj, err := clnt.GetObject(ctx, "bucket", "object", GetObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = io.ReadAll(obj); err != nil {
+		t.Fatal(err)
+	}
+	obj.Close()
+
+	// The GetObject request ends once its body is closed in the background.
+	var ends []RequestInfo
+	for range 3 {
+		select {
+		case info := <-hooks.ends:
+			ends = append(ends, info)
+		case <-time.After(5 * time.Second):
+			t.Fatal("Timed out waiting for requests to end")
+		}
+	}
+
+	hooks.mu.Lock()
+	defer hooks.mu.Unlock()
+	want := "start PutObject,retry SlowDown,end PutObject,start HeadObject,end HeadObject,start GetObject,end GetObject"
+	if got := strings.Join(hooks.events, ","); got != want {
+		t.Fatalf("Expected %s, got %s", want, got)
+	}
+	put, head, get := ends[0], ends[1], ends[2]
+	if put.Attempt != 1 || put.StatusCode != http.StatusOK || put.Err != nil || put.BytesSent != 2*int64(len(data)) {
+		t.Fatalf("Unexpected PutObject info %+v", put)
+	}
+	if head.StatusCode != http.StatusNotFound || head.ErrorCode != "NoSuchKey" || head.Err == nil {
+		t.Fatalf("Unexpected HeadObject info %+v", head)
+	}
+	if get.BytesReceived != int64(len(data)) || get.BucketName != "bucket" || get.ObjectName != "object" {
+		t.Fatalf("Unexpected GetObject info %+v", get)
+	}
+}
+
+func TestS3Operation(t *testing.T) {
+	testCases := []struct {
+		method   string
+		metadata requestMetadata
+		want     string
+	}{
+		{http.MethodGet, requestMetadata{}, "ListBuckets"},
+		{http.MethodGet, requestMetadata{bucketName: "b", queryValues: url.Values{"list-type": {"2"}}}, "ListObjectsV2"},
+		{http.MethodPut, requestMetadata{bucketName: "b", queryValues: url.Values{"versioning": {""}}}, "PutBucketVersioning"},
+		{http.MethodPost, requestMetadata{bucketName: "b", queryValues: url.Values{"delete": {""}}}, "DeleteObjects"},
+		{http.MethodDelete, requestMetadata{bucketName: "b", objectName: "o", queryValues: url.Values{"tagging": {""}}}, "DeleteObjectTagging"},
+		{http.MethodPost, requestMetadata{bucketName: "b", objectName: "o", queryValues: url.Values{"uploads": {""}}}, "CreateMultipartUpload"},
+		{http.MethodPut, requestMetadata{bucketName: "b", objectName: "o", queryValues: url.Values{"uploadId": {"id"}, "partNumber": {"1"}}}, "UploadPart"},
+		{http.MethodPut, requestMetadata{bucketName: "b", objectName: "o", customHeader: http.Header{"X-Amz-Copy-Source": {"/b/src"}}}, "CopyObject"},
+	}
+	for _, tc := range testCases {
+		if got := s3Operation(tc.method, tc.metadata); got != tc.want {
+			t.Errorf("Expected %s, got %s", tc.want, got)
+		}
+	}
+}
@@ -0,0 +1,284 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Command clientgen generates the MockClient and ClientDecorator types
+// of package minio from the API interface, so they always implement
+// every method of it.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"strings"
+)
+
+const header = `/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by clientgen from %s. DO NOT EDIT.
+
+package %s
+
+`
+
+// method - a method of the API interface.
+type method struct {
+	name string
+	// Parameters as declared, e.g. "ctx context.Context".
+	params []string
+	// Arguments to forward the parameters, e.g. "ctx".
+	args []string
+	// Result types.
+	results []string
+}
+
+func (m method) signature() string {
+	s := m.name + "(" + strings.Join(m.params, ", ") + ")"
+	switch len(m.results) {
+	case 0:
+		return s
+	case 1:
+		return s + " " + m.results[0]
+	default:
+		return s + " (" + strings.Join(m.results, ", ") + ")"
+	}
+}
+
+func (m method) returnsError() bool {
+	return len(m.results) > 0 && m.results[len(m.results)-1] == "error"
+}
+
+// parsed - the package, imports and API methods of a source file.
+type parsed struct {
+	pkg     string
+	imports []string
+	methods []method
+}
+
+func parse(filename string, src []byte) (*parsed, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filename, src, 0)
+	if err != nil {
+		return nil, err
+	}
+	p := &parsed{pkg: f.Name.Name}
+	for _, spec := range f.Imports {
+		p.imports = append(p.imports, spec.Path.Value)
+	}
+
+	interfaces := make(map[string]*ast.InterfaceType)
+	ast.Inspect(f, func(n ast.Node) bool {
+		if spec, ok := n.(*ast.TypeSpec); ok {
+			if it, ok := spec.Type.(*ast.InterfaceType); ok {
+				interfaces[spec.Name.Name] = it
+			}
+		}
+		return true
+	})
+	expr := func(e ast.Expr) string {
+		var buf bytes.Buffer
+		printer.Fprint(&buf, fset, e)
+		return buf.String()
+	}
+
+	var collect func(name string) error
+	collect = func(name string) error {
+		it, ok := interfaces[name]
+		if !ok {
+			return fmt.Errorf("%s: interface %s not found", filename, name)
+		}
+		for _, field := range it.Methods.List {
+			ft, ok := field.Type.(*ast.FuncType)
+			if !ok {
+				// An embedded interface.
+				if err := collect(expr(field.Type)); err != nil {
+					return err
+				}
+				continue
+			}
+			m := method{name: field.Names[0].Name}
+			for _, param := range ft.Params.List {
+				if len(param.Names) == 0 {
+					return fmt.Errorf("%s: parameters of %s.%s must be named", filename, name, m.name)
+				}
+				var names []string
+				for _, n := range param.Names {
+					names = append(names, n.Name)
+					arg := n.Name
+					if _, ok := param.Type.(*ast.Ellipsis); ok {
+						arg += "..."
+					}
+					m.args = append(m.args, arg)
+				}
+				m.params = append(m.params, strings.Join(names, ", ")+" "+expr(param.Type))
+			}
+			if ft.Results != nil {
+				for _, result := range ft.Results.List {
+					for range max(len(result.Names), 1) {
+						m.results = append(m.results, expr(result.Type))
+					}
+				}
+			}
+			p.methods = append(p.methods, m)
+		}
+		return nil
+	}
+	if err = collect("API"); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *parsed) writeHeader(buf *bytes.Buffer, filename string) {
+	fmt.Fprintf(buf, header, filename, p.pkg)
+	// Standard library imports first, as goimports groups them.
+	var std, other []string
+	for _, imp := range p.imports {
+		if first, _, _ := strings.Cut(imp, "/"); strings.Contains(first, ".") {
+			other = append(other, imp)
+		} else {
+			std = append(std, imp)
+		}
+	}
+	buf.WriteString("import (\n")
+	buf.WriteString(strings.Join(std, "\n"))
+	if len(std) > 0 && len(other) > 0 {
+		buf.WriteString("\n")
+	}
+	buf.WriteString("\n" + strings.Join(other, "\n"))
+	buf.WriteString("\n)\n\n")
+}
+
+// generateMock - returns the source of MockClient.
+func (p *parsed) generateMock(filename string) ([]byte, error) {
+	var buf bytes.Buffer
+	p.writeHeader(&buf, filename)
+	buf.WriteString(`// MockClient is an API calling the function field named after each
+// method with a Func suffix, to substitute a Client in tests. Methods
+// whose function is nil return zero values and an APINotSupported
+// error, or panic if they return no error.
+type MockClient struct {
+`)
+	for _, m := range p.methods {
+		fmt.Fprintf(&buf, "%sFunc func%s\n", m.name, strings.TrimPrefix(m.signature(), m.name))
+	}
+	buf.WriteString("}\n\nvar _ API = (*MockClient)(nil)\n")
+
+	for _, m := range p.methods {
+		fmt.Fprintf(&buf, "\n// %s calls m.%sFunc.\n", m.name, m.name)
+		named := m
+		if m.returnsError() {
+			named.results = nil
+			for i, result := range m.results[:len(m.results)-1] {
+				named.results = append(named.results, fmt.Sprintf("r%d %s", i, result))
+			}
+			named.results = append(named.results, "err error")
+			if len(named.results) == 1 {
+				named.results[0] = "(err error)"
+			}
+		}
+		fmt.Fprintf(&buf, "func (m *MockClient) %s {\n", named.signature())
+		fmt.Fprintf(&buf, "if m.%sFunc == nil {\n", m.name)
+		if m.returnsError() {
+			fmt.Fprintf(&buf, "err = errAPINotSupported(%q)\nreturn\n", "MockClient."+m.name+" is not mocked")
+		} else {
+			fmt.Fprintf(&buf, "panic(%q)\n", "minio: MockClient."+m.name+" is not mocked")
+		}
+		buf.WriteString("}\n")
+		call := fmt.Sprintf("m.%sFunc(%s)", m.name, strings.Join(m.args, ", "))
+		if len(m.results) > 0 {
+			buf.WriteString("return ")
+		}
+		buf.WriteString(call + "\n}\n")
+	}
+	return format.Source(buf.Bytes())
+}
+
+// generateDecorator - returns the source of ClientDecorator.
+func (p *parsed) generateDecorator(filename string) ([]byte, error) {
+	var buf bytes.Buffer
+	p.writeHeader(&buf, filename)
+	buf.WriteString(`// ClientDecorator is an API forwarding every call to Next. Embed it in
+// a type overriding only the methods to decorate, e.g. to add caching,
+// logging or policies to a Client.
+type ClientDecorator struct {
+	Next API
+}
+
+var _ API = ClientDecorator{}
+`)
+	for _, m := range p.methods {
+		fmt.Fprintf(&buf, "\n// %s calls d.Next.%s.\n", m.name, m.name)
+		fmt.Fprintf(&buf, "func (d ClientDecorator) %s {\n", m.signature())
+		if len(m.results) > 0 {
+			buf.WriteString("return ")
+		}
+		fmt.Fprintf(&buf, "d.Next.%s(%s)\n}\n", m.name, strings.Join(m.args, ", "))
+	}
+	return format.Source(buf.Bytes())
+}
+
+func main() {
+	in := flag.String("in", "api-interfaces.go", "source file declaring the API interface")
+	mock := flag.String("mock", "api-mock.go", "output file of MockClient")
+	decorator := flag.String("decorator", "api-decorator.go", "output file of ClientDecorator")
+	flag.Parse()
+
+	src, err := os.ReadFile(*in)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	p, err := parse(*in, src)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	out, err := p.generateMock(*in)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	if err = os.WriteFile(*mock, out, 0o644); err != nil {
+		log.Fatalln(err)
+	}
+	if out, err = p.generateDecorator(*in); err != nil {
+		log.Fatalln(err)
+	}
+	if err = os.WriteFile(*decorator, out, 0o644); err != nil {
+		log.Fatalln(err)
+	}
+}
@@ -0,0 +1,91 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestGenerated fails when the generated files of package minio are out
+// of date with the API interface; run go generate to update them.
+func TestGenerated(t *testing.T) {
+	root := filepath.Join("..", "..")
+	src, err := os.ReadFile(filepath.Join(root, "api-interfaces.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := parse("api-interfaces.go", src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mock, err := p.generateMock("api-interfaces.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	decorator, err := p.generateDecorator("api-interfaces.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range map[string][]byte{"api-mock.go": mock, "api-decorator.go": decorator} {
+		got, err := os.ReadFile(filepath.Join(root, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s is out of date, run go generate", name)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	src := []byte(`package p
+
+type A interface {
+	Get(ctx context.Context, a, b string, opts ...int) (n int, err error)
+}
+
+type API interface {
+	A
+	Close()
+}
+`)
+	p, err := parse("p.go", src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p.methods) != 2 {
+		t.Fatalf("expected 2 methods, got %d", len(p.methods))
+	}
+	get := p.methods[0]
+	if s := get.signature(); s != "Get(ctx context.Context, a, b string, opts ...int) (int, error)" {
+		t.Fatalf("unexpected signature %s", s)
+	}
+	if len(get.args) != 4 || get.args[3] != "opts..." || !get.returnsError() {
+		t.Fatalf("unexpected method %+v", get)
+	}
+	if p.methods[1].signature() != "Close()" || p.methods[1].returnsError() {
+		t.Fatalf("unexpected method %+v", p.methods[1])
+	}
+
+	if _, err = parse("p.go", []byte("package p\n\ntype API interface{ Get(string) }\n")); err == nil {
+		t.Fatal("expected an error for unnamed parameters")
+	}
+}
@@ -0,0 +1,135 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const redacted = "**REDACTED**"
+
+// Headers carrying credentials or keys, never logged as is.
+var sensitiveHeaders = map[string]bool{
+	"Authorization":         true,
+	"Proxy-Authorization":   true,
+	"Cookie":                true,
+	"Set-Cookie":            true,
+	"X-Amz-Security-Token":  true,
+	"X-Amz-S3session-Token": true,
+	"X-Amz-Server-Side-Encryption-Customer-Key":             true,
+	"X-Amz-Copy-Source-Server-Side-Encryption-Customer-Key": true,
+}
+
+// Query parameters of presigned URLs carrying credentials.
+var sensitiveQuery = []string{
+	"X-Amz-Credential",
+	"X-Amz-Signature",
+	"X-Amz-Security-Token",
+	"X-Amz-S3session-Token",
+	"AWSAccessKeyId",
+	"Signature",
+}
+
+// redactHeader - returns a copy of h with all credentials redacted.
+func redactHeader(h http.Header) http.Header {
+	h = h.Clone()
+	for key, values := range h {
+		if !sensitiveHeaders[key] {
+			continue
+		}
+		for i, value := range values {
+			if key == "Authorization" {
+				values[i] = redactSignature(value)
+			} else {
+				values[i] = redacted
+			}
+		}
+	}
+	return h
+}
+
+// redactURL - returns u with all credentials in the query redacted.
+func redactURL(u *url.URL) string {
+	query := u.Query()
+	var found bool
+	for _, key := range sensitiveQuery {
+		if query.Has(key) {
+			query.Set(key, redacted)
+			found = true
+		}
+	}
+	if !found {
+		return u.String()
+	}
+	redactedURL := *u
+	redactedURL.RawQuery = query.Encode()
+	return redactedURL.String()
+}
+
+// headerAttrs - returns the redacted header h as log attributes.
+func headerAttrs(h http.Header) []any {
+	attrs := make([]any, 0, len(h))
+	for key, values := range redactHeader(h) {
+		if len(values) == 1 {
+			attrs = append(attrs, slog.String(key, values[0]))
+		} else {
+			attrs = append(attrs, slog.Any(key, values))
+		}
+	}
+	return attrs
+}
+
+// logAttempt - logs a single request attempt and its response, res is
+// nil if no response was received. Successful attempts are logged at
+// debug level, failed ones at info level. Error response bodies are
+// only logged if enabled.
+func (c *Client) logAttempt(req *http.Request, res *http.Response, attempt int, start time.Time, err error, errBody []byte) {
+	if c.logger == nil {
+		return
+	}
+	attrs := []any{
+		slog.String("method", req.Method),
+		slog.String("url", redactURL(req.URL)),
+		slog.Int("attempt", attempt),
+		slog.Duration("duration", time.Since(start)),
+		slog.Group("request_headers", headerAttrs(req.Header)...),
+	}
+	if res != nil {
+		attrs = append(attrs,
+			slog.Int("status", res.StatusCode),
+			slog.Group("response_headers", headerAttrs(res.Header)...))
+	}
+	level := slog.LevelDebug
+	if err != nil {
+		level = slog.LevelInfo
+		if errResp, ok := err.(ErrorResponse); ok && errResp.Code != "" {
+			attrs = append(attrs, slog.String("error_code", errResp.Code))
+		}
+		if urlErr, ok := err.(*url.Error); ok {
+			err = &url.Error{Op: urlErr.Op, URL: redactURL(req.URL), Err: urlErr.Err}
+		}
+		attrs = append(attrs, slog.String("error", err.Error()))
+		if c.logErrorBodies && len(errBody) > 0 {
+			attrs = append(attrs, slog.String("body", string(errBody)))
+		}
+	}
+	c.logger.Log(req.Context(), level, "S3 request", attrs...)
+}
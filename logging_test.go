@@ -0,0 +1,112 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio-go/v7/pkg/encrypt"
+)
+
+func TestLogger(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`<Error><Code>AccessDenied</Code><Message>Access Denied.</Message></Error>`))
+	}))
+	defer srv.Close()
+
+	var buf bytes.Buffer
+	clnt, err := New(srv.Listener.Addr().String(), &Options{
+		Region:         "us-east-1",
+		Creds:          credentials.NewStaticV4("accesskey", "secretkey", "sessiontoken"),
+		Logger:         slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})),
+		LogErrorBodies: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sse := encrypt.DefaultPBKDF([]byte("password"), []byte("bucketobject"))
+	_, err = clnt.GetObject(context.Background(), "bucket", "object", GetObjectOptions{ServerSideEncryption: sse})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = clnt.StatObject(context.Background(), "bucket", "object", StatObjectOptions{ServerSideEncryption: sse}); err == nil {
+		t.Fatal("Expected StatObject to fail")
+	}
+
+	h := make(http.Header)
+	sse.Marshal(h)
+	for _, secret := range []string{"accesskey", "sessiontoken", h.Get("X-Amz-Server-Side-Encryption-Customer-Key")} {
+		if strings.Contains(buf.String(), secret) {
+			t.Fatalf("Expected %q to be redacted in %s", secret, buf.String())
+		}
+	}
+
+	var record struct {
+		Level     string
+		Method    string
+		Status    int
+		ErrorCode string `json:"error_code"`
+		Attempt   int
+		Headers   map[string]string `json:"request_headers"`
+	}
+	if err = json.Unmarshal(bytes.Split(buf.Bytes(), []byte("\n"))[0], &record); err != nil {
+		t.Fatal(err)
+	}
+	if record.Level != "INFO" || record.Method != http.MethodHead || record.Status != http.StatusForbidden ||
+		record.ErrorCode != "AccessDenied" || record.Attempt != 0 {
+		t.Fatalf("Unexpected record %+v", record)
+	}
+	if record.Headers["X-Amz-Security-Token"] != redacted {
+		t.Fatalf("Expected session token to be redacted, got %q", record.Headers["X-Amz-Security-Token"])
+	}
+
+	// S3 Express session tokens are set by newRequest for directory
+	// buckets.
+	h = make(http.Header)
+	h.Set("x-amz-s3session-token", "s3sessiontoken")
+	if got := redactHeader(h).Get("X-Amz-S3session-Token"); got != redacted {
+		t.Fatalf("Expected S3 Express session token to be redacted, got %q", got)
+	}
+}
+
+func TestRedactURL(t *testing.T) {
+	u, err := url.Parse("https://s3.amazonaws.com/bucket/object?X-Amz-Algorithm=AWS4-HMAC-SHA256&X-Amz-Credential=AKIA%2F20250101%2Fus-east-1%2Fs3%2Faws4_request&X-Amz-Signature=abcdef&versionId=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := redactURL(u)
+	for _, secret := range []string{"AKIA", "abcdef"} {
+		if strings.Contains(got, secret) {
+			t.Fatalf("Expected %q to be redacted in %s", secret, got)
+		}
+	}
+	if !strings.Contains(got, "versionId=1") || !strings.Contains(got, "X-Amz-Algorithm=AWS4-HMAC-SHA256") {
+		t.Fatalf("Expected other parameters to be kept in %s", got)
+	}
+}
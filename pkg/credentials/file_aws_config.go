@@ -0,0 +1,269 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package credentials
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A FileAWSConfig retrieves the credentials of a profile of the AWS
+// shared credentials and config files, resolving it the way the AWS CLI
+// does:
+//
+//   - a profile with role_arn and web_identity_token_file assumes the
+//     role with the web identity token read from the file.
+//   - a profile with role_arn assumes the role with the credentials of
+//     its source_profile, which may itself assume a role, or of its
+//     credential_source: Environment, Ec2InstanceMetadata or
+//     EcsContainer. external_id, duration_seconds and role_session_name
+//     are passed to AssumeRole.
+//   - other profiles return the credentials of their credential_process
+//     or their static keys.
+//
+// The credentials are cached until they expire, at which point the
+// whole chain of profiles is resolved again.
+//
+// Config ini file example: $HOME/.aws/config
+type FileAWSConfig struct {
+	Expiry
+
+	// Optional http Client to use when connecting to AWS STS service
+	// (overrides default client in CredContext)
+	Client *http.Client
+
+	// Optional STS endpoint to assume roles, defaults to the endpoint of
+	// the region of the profile.
+	STSEndpoint string
+
+	// Path to the shared credentials file, see FileAWSCredentials.
+	Filename string
+
+	// Path to the config file, see FileAWSCredentials.
+	ConfigFilename string
+
+	// AWS Profile to resolve. If empty will default to environment
+	// variable "AWS_PROFILE" or "default" if environment variable is also
+	// not set.
+	Profile string
+
+	// retrieved states if the credentials have been successfully retrieved.
+	retrieved bool
+
+	// expires states if the retrieved credentials have an expiration.
+	expires bool
+}
+
+// NewFileAWSConfig returns a pointer to a new Credentials object
+// wrapping the AWS config profile provider.
+func NewFileAWSConfig(filename, configFilename, profile string) *Credentials {
+	return New(&FileAWSConfig{
+		Filename:       filename,
+		ConfigFilename: configFilename,
+		Profile:        profile,
+	})
+}
+
+// RetrieveWithCredContext resolves the profile, assuming the roles it
+// chains with the optional cred context.
+func (p *FileAWSConfig) RetrieveWithCredContext(cc *CredContext) (Value, error) {
+	if cc == nil {
+		cc = defaultCredContext
+	}
+
+	client := p.Client
+	if client == nil {
+		client = cc.Client
+	}
+	if client == nil {
+		client = defaultCredContext.Client
+	}
+
+	var err error
+	p.Filename, p.ConfigFilename, err = awsFilenames(p.Filename, p.ConfigFilename)
+	if err != nil {
+		return Value{}, err
+	}
+	if p.Profile == "" {
+		p.Profile = os.Getenv("AWS_PROFILE")
+		if p.Profile == "" {
+			p.Profile = "default"
+		}
+	}
+
+	p.retrieved = false
+	p.expires = false
+
+	// Every role of the chain is assumed in the region of the requested
+	// profile, as the AWS CLI does, which AssumeRole requests are signed
+	// for.
+	region := os.Getenv("AWS_REGION")
+	if region == "" {
+		profile, err := loadAWSProfile(p.Filename, p.ConfigFilename, p.Profile)
+		if err != nil {
+			return Value{}, err
+		}
+		region = profile.get("region")
+	}
+	if region == "" {
+		region = os.Getenv("AWS_DEFAULT_REGION")
+	}
+	if region == "" {
+		region = "us-east-1"
+	}
+
+	value, err := p.resolve(client, p.Profile, region, nil)
+	if err != nil {
+		return Value{}, err
+	}
+	p.retrieved = true
+	if !value.Expiration.IsZero() {
+		p.expires = true
+		p.SetExpiration(value.Expiration, DefaultExpiryWindow)
+	}
+	return value, nil
+}
+
+// Retrieve resolves the profile, assuming the roles it chains.
+func (p *FileAWSConfig) Retrieve() (Value, error) {
+	return p.RetrieveWithCredContext(nil)
+}
+
+// IsExpired returns if the credentials have not been retrieved or have
+// expired.
+func (p *FileAWSConfig) IsExpired() bool {
+	if !p.retrieved {
+		return true
+	}
+	return p.expires && p.Expiry.IsExpired()
+}
+
+// resolve - returns the credentials of the profile name, visited through
+// the source profiles listed in chain, assuming roles in region.
+func (p *FileAWSConfig) resolve(client *http.Client, name, region string, chain []string) (Value, error) {
+	if slices.Contains(chain, name) {
+		return Value{}, fmt.Errorf("profile %s: source_profile cycle %s", name, strings.Join(append(chain, name), " -> "))
+	}
+	chain = append(chain, name)
+
+	profile, err := loadAWSProfile(p.Filename, p.ConfigFilename, name)
+	if err != nil {
+		return Value{}, err
+	}
+
+	roleARN := profile.get("role_arn")
+	if roleARN == "" {
+		if profile.get("web_identity_token_file") != "" {
+			return Value{}, fmt.Errorf("profile %s: web_identity_token_file requires role_arn", name)
+		}
+		value, err := profileCredentials(profile)
+		if err != nil {
+			return Value{}, err
+		}
+		if value.AccessKeyID == "" || value.SecretAccessKey == "" {
+			return Value{}, fmt.Errorf("profile %s: no credentials", name)
+		}
+		return value, nil
+	}
+
+	if profile.get("mfa_serial") != "" {
+		return Value{}, fmt.Errorf("profile %s: mfa_serial is not supported", name)
+	}
+
+	var durationSeconds int
+	if duration := profile.get("duration_seconds"); duration != "" {
+		if durationSeconds, err = strconv.Atoi(duration); err != nil || durationSeconds <= 0 {
+			return Value{}, fmt.Errorf("profile %s: invalid duration_seconds %q", name, duration)
+		}
+	}
+	stsEndpoint := p.STSEndpoint
+	if stsEndpoint == "" {
+		stsEndpoint = stsEndpointForRegion(region)
+	}
+	roleSessionName := profile.get("role_session_name")
+	if roleSessionName == "" {
+		roleSessionName = strconv.FormatInt(time.Now().UnixNano(), 10)
+	}
+
+	if tokenFile := profile.get("web_identity_token_file"); tokenFile != "" {
+		webIdentity := &STSWebIdentity{
+			Client:      client,
+			STSEndpoint: stsEndpoint,
+			GetWebIDTokenExpiry: func() (*WebIdentityToken, error) {
+				token, err := os.ReadFile(tokenFile)
+				if err != nil {
+					return nil, err
+				}
+				return &WebIdentityToken{Token: strings.TrimSpace(string(token)), Expiry: durationSeconds}, nil
+			},
+			RoleARN:         roleARN,
+			roleSessionName: roleSessionName,
+		}
+		return webIdentity.Retrieve()
+	}
+
+	var source Value
+	sourceProfile := profile.get("source_profile")
+	switch credentialSource := profile.get("credential_source"); {
+	case sourceProfile != "" && credentialSource != "":
+		return Value{}, fmt.Errorf("profile %s: source_profile and credential_source are exclusive", name)
+	case sourceProfile == name:
+		// A profile may assume a role with its own static keys.
+		source, err = profileCredentials(profile)
+		if err == nil && (source.AccessKeyID == "" || source.SecretAccessKey == "") {
+			err = fmt.Errorf("profile %s: no credentials", name)
+		}
+	case sourceProfile != "":
+		source, err = p.resolve(client, sourceProfile, region, chain)
+	case credentialSource == "Environment":
+		source, err = (&EnvAWS{}).Retrieve()
+		if err == nil && source.SignerType.IsAnonymous() {
+			err = fmt.Errorf("profile %s: no credentials in environment", name)
+		}
+	case credentialSource == "Ec2InstanceMetadata", credentialSource == "EcsContainer":
+		source, err = (&IAM{Client: client}).Retrieve()
+	case credentialSource != "":
+		return Value{}, fmt.Errorf("profile %s: unknown credential_source %q", name, credentialSource)
+	default:
+		return Value{}, fmt.Errorf("profile %s: role_arn requires source_profile, credential_source or web_identity_token_file", name)
+	}
+	if err != nil {
+		return Value{}, err
+	}
+
+	assumeRole := &STSAssumeRole{
+		Client:      client,
+		STSEndpoint: stsEndpoint,
+		Options: STSAssumeRoleOptions{
+			AccessKey:       source.AccessKeyID,
+			SecretKey:       source.SecretAccessKey,
+			SessionToken:    source.SessionToken,
+			Location:        region,
+			DurationSeconds: durationSeconds,
+			RoleARN:         roleARN,
+			RoleSessionName: roleSessionName,
+			ExternalID:      profile.get("external_id"),
+		},
+	}
+	return assumeRole.Retrieve()
+}
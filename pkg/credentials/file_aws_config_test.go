@@ -0,0 +1,287 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package credentials
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeSTS - an STS server returning credentials named after the role
+// assumed, and recording the requests it receives.
+type fakeSTS struct {
+	mu       sync.Mutex
+	requests []string
+}
+
+func (s *fakeSTS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	role := r.Form.Get("RoleArn")
+	role = role[strings.LastIndex(role, "/")+1:]
+	var request string
+	switch action := r.Form.Get("Action"); action {
+	case "AssumeRole":
+		// Credential=KEY/DATE/REGION/sts/aws4_request
+		_, credential, _ := strings.Cut(r.Header.Get("Authorization"), "Credential=")
+		credential, _, _ = strings.Cut(credential, ",")
+		scope := strings.Split(credential, "/")
+		if len(scope) != 5 || scope[3] != "sts" {
+			http.Error(w, "unexpected credential "+credential, http.StatusForbidden)
+			return
+		}
+		request = fmt.Sprintf("AssumeRole %s by %s in %s token=%s session=%s duration=%s external=%s",
+			role, scope[0], scope[2], r.Header.Get("X-Amz-Security-Token"), r.Form.Get("RoleSessionName"),
+			r.Form.Get("DurationSeconds"), r.Form.Get("ExternalId"))
+	case "AssumeRoleWithWebIdentity":
+		request = fmt.Sprintf("AssumeRoleWithWebIdentity %s with %s session=%s",
+			role, r.Form.Get("WebIdentityToken"), r.Form.Get("RoleSessionName"))
+	default:
+		http.Error(w, "unexpected action "+action, http.StatusBadRequest)
+		return
+	}
+	s.mu.Lock()
+	s.requests = append(s.requests, request)
+	s.mu.Unlock()
+
+	fmt.Fprintf(w, `<%[1]sResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+<%[1]sResult>
+<Credentials>
+<AccessKeyId>key-%[2]s</AccessKeyId>
+<SecretAccessKey>secret-%[2]s</SecretAccessKey>
+<SessionToken>token-%[2]s</SessionToken>
+<Expiration>%[3]s</Expiration>
+</Credentials>
+</%[1]sResult>
+</%[1]sResponse>`, r.Form.Get("Action"), role, time.Now().Add(time.Hour).UTC().Format(time.RFC3339))
+}
+
+func (s *fakeSTS) take() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	requests := s.requests
+	s.requests = nil
+	return requests
+}
+
+func TestFileAWSConfigRoles(t *testing.T) {
+	sts := &fakeSTS{}
+	srv := httptest.NewServer(sts)
+	defer srv.Close()
+
+	dir := t.TempDir()
+	tokenFile := filepath.Join(dir, "token")
+	if err := os.WriteFile(tokenFile, []byte("web-token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	configFile := filepath.Join(dir, "config")
+	config := `[profile base]
+region = ap-south-1
+
+[profile first]
+role_arn = arn:aws:iam::123456789012:role/first
+source_profile = base
+external_id = ext
+duration_seconds = 7200
+role_session_name = session
+
+[profile chained]
+role_arn = arn:aws:iam::123456789012:role/second
+source_profile = first
+region = eu-west-1
+
+[profile self]
+role_arn = arn:aws:iam::123456789012:role/self
+source_profile = self
+aws_access_key_id = selfKey
+aws_secret_access_key = selfSecret
+role_session_name = session
+
+[profile web]
+role_arn = arn:aws:iam::123456789012:role/web
+web_identity_token_file = ` + tokenFile + `
+role_session_name = session
+
+[profile cycle-a]
+role_arn = arn:aws:iam::123456789012:role/a
+source_profile = cycle-b
+
+[profile cycle-b]
+role_arn = arn:aws:iam::123456789012:role/b
+source_profile = cycle-a
+
+[profile no_source]
+role_arn = arn:aws:iam::123456789012:role/none
+`
+	if err := os.WriteFile(configFile, []byte(config), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	credentialsFile := filepath.Join(dir, "credentials")
+	if err := os.WriteFile(credentialsFile, []byte("[base]\naws_access_key_id = baseKey\naws_secret_access_key = baseSecret\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	os.Clearenv()
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", credentialsFile)
+	t.Setenv("AWS_CONFIG_FILE", configFile)
+
+	newProvider := func(profile string) *FileAWSConfig {
+		return &FileAWSConfig{STSEndpoint: srv.URL, Profile: profile}
+	}
+
+	testCases := []struct {
+		profile  string
+		key      string
+		requests []string
+		wantErr  string
+	}{
+		{
+			profile: "base",
+			key:     "baseKey",
+		},
+		{
+			profile:  "first",
+			key:      "key-first",
+			requests: []string{"AssumeRole first by baseKey in us-east-1 token= session=session duration=7200 external=ext"},
+		},
+		{
+			// The region of the requested profile is used for the
+			// whole chain.
+			profile: "chained",
+			key:     "key-second",
+			requests: []string{
+				"AssumeRole first by baseKey in eu-west-1 token= session=session duration=7200 external=ext",
+				"AssumeRole second by key-first in eu-west-1 token=token-first session=",
+			},
+		},
+		{
+			profile:  "self",
+			key:      "key-self",
+			requests: []string{"AssumeRole self by selfKey in us-east-1 token= session=session duration=3600 external="},
+		},
+		{
+			profile:  "web",
+			key:      "key-web",
+			requests: []string{"AssumeRoleWithWebIdentity web with web-token session=session"},
+		},
+		{
+			profile: "cycle-a",
+			wantErr: "source_profile cycle cycle-a -> cycle-b -> cycle-a",
+		},
+		{
+			profile: "no_source",
+			wantErr: "role_arn requires source_profile",
+		},
+		{
+			profile: "missing",
+			wantErr: "section \"missing\" does not exist",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.profile, func(t *testing.T) {
+			value, err := newProvider(testCase.profile).Retrieve()
+			requests := sts.take()
+			if testCase.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.wantErr) {
+					t.Fatalf("expected error %q, got %v", testCase.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if value.AccessKeyID != testCase.key {
+				t.Errorf("expected %s, got %s", testCase.key, value.AccessKeyID)
+			}
+			if len(requests) != len(testCase.requests) {
+				t.Fatalf("expected requests %q, got %q", testCase.requests, requests)
+			}
+			for i, request := range requests {
+				if !strings.HasPrefix(request, testCase.requests[i]) {
+					t.Errorf("expected request %q, got %q", testCase.requests[i], request)
+				}
+			}
+		})
+	}
+
+	// The region falls back to AWS_DEFAULT_REGION, and AWS_REGION
+	// overrides the region of the profile.
+	t.Setenv("AWS_DEFAULT_REGION", "eu-central-1")
+	for profile, want := range map[string]string{"first": "eu-central-1", "chained": "eu-west-1"} {
+		if _, err := newProvider(profile).Retrieve(); err != nil {
+			t.Fatal(err)
+		}
+		requests := sts.take()
+		if len(requests) == 0 {
+			t.Fatalf("%s: expected AssumeRole requests", profile)
+		}
+		for _, request := range requests {
+			if !strings.Contains(request, " in "+want+" ") {
+				t.Errorf("%s: expected region %s, got %q", profile, want, request)
+			}
+		}
+	}
+	t.Setenv("AWS_REGION", "sa-east-1")
+	if _, err := newProvider("chained").Retrieve(); err != nil {
+		t.Fatal(err)
+	}
+	requests := sts.take()
+	if len(requests) != 2 {
+		t.Fatalf("expected the chain to be resolved, got %q", requests)
+	}
+	for _, request := range requests {
+		if !strings.Contains(request, " in sa-east-1 ") {
+			t.Errorf("expected region sa-east-1, got %q", request)
+		}
+	}
+
+	// The profile defaults to AWS_PROFILE, and the credentials are cached
+	// until they expire.
+	t.Setenv("AWS_PROFILE", "chained")
+	now := time.Now()
+	p := &FileAWSConfig{STSEndpoint: srv.URL}
+	p.CurrentTime = func() time.Time { return now }
+	creds := New(p)
+	for range 2 {
+		value, err := creds.GetWithContext(defaultCredContext)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if value.AccessKeyID != "key-second" || value.SessionToken != "token-second" {
+			t.Fatalf("unexpected credentials %+v", value)
+		}
+	}
+	if requests := sts.take(); len(requests) != 2 {
+		t.Fatalf("expected the chain to be resolved once, got %q", requests)
+	}
+	now = now.Add(time.Hour)
+	if _, err := creds.GetWithContext(defaultCredContext); err != nil {
+		t.Fatal(err)
+	}
+	if requests := sts.take(); len(requests) != 2 {
+		t.Fatalf("expected the chain to be resolved again, got %q", requests)
+	}
+}
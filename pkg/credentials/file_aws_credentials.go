@@ -18,7 +18,9 @@
 package credentials
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -41,6 +43,11 @@ type externalProcessCredentials struct {
 // A FileAWSCredentials retrieves credentials from the current user's home
 // directory, and keeps track if those credentials are expired.
 //
+// The profile is read from the shared credentials file and the config
+// file, the former taking precedence. Credentials are either static keys
+// or the output of the command set by credential_process, which is run
+// again when the credentials it returned expire.
+//
 // Profile ini file example: $HOME/.aws/credentials
 type FileAWSCredentials struct {
 	Expiry
@@ -53,6 +60,15 @@ type FileAWSCredentials struct {
 	// Windows:   "%USERPROFILE%\.aws\credentials"
 	Filename string
 
+	// Path to the config file, whose profiles are named "profile NAME"
+	// except for the default profile.
+	//
+	// If empty will look for "AWS_CONFIG_FILE" env variable. If the env
+	// value is empty will default to current user's home directory.
+	// Linux/OSX: "$HOME/.aws/config"
+	// Windows:   "%USERPROFILE%\.aws\config"
+	ConfigFilename string
+
 	// AWS Profile to extract credentials from the shared credentials file. If empty
 	// will default to environment variable "AWS_PROFILE" or "default" if
 	// environment variable is also not set.
@@ -60,6 +76,11 @@ type FileAWSCredentials struct {
 
 	// retrieved states if the credentials have been successfully retrieved.
 	retrieved bool
+
+	// fromProcess states if the credentials were returned by a
+	// credential_process, and expires if they have an expiration.
+	fromProcess bool
+	expires     bool
 }
 
 // NewFileAWSCredentials returns a pointer to a new Credentials object
@@ -71,17 +92,74 @@ func NewFileAWSCredentials(filename, profile string) *Credentials {
 	})
 }
 
-func (p *FileAWSCredentials) retrieve() (Value, error) {
-	if p.Filename == "" {
-		p.Filename = os.Getenv("AWS_SHARED_CREDENTIALS_FILE")
-		if p.Filename == "" {
-			homeDir, err := os.UserHomeDir()
-			if err != nil {
-				return Value{}, err
+// awsProfile - the sections of a profile in the shared credentials file
+// and the config file, in order of precedence.
+type awsProfile []*ini.Section
+
+// get - returns the value of key in the profile, empty if not found.
+func (p awsProfile) get(key string) string {
+	for _, section := range p {
+		if section.HasKey(key) {
+			return strings.TrimSpace(section.Key(key).String())
+		}
+	}
+	return ""
+}
+
+// loadAWSProfile - loads profile from the shared credentials file and
+// the config file. The config file is optional, an error is returned if
+// the profile is not found in it and the credentials file cannot be read
+// or has no such profile.
+func loadAWSProfile(credentialsFile, configFile, profile string) (awsProfile, error) {
+	var p awsProfile
+	section, err := loadProfile(credentialsFile, profile)
+	if err == nil {
+		p = append(p, section)
+	}
+	if configFile != "" {
+		name := profile
+		if name != "default" {
+			name = "profile " + name
+		}
+		if section, cerr := loadProfile(configFile, name); cerr == nil {
+			p = append(p, section)
+		}
+	}
+	if len(p) == 0 {
+		return nil, err
+	}
+	return p, nil
+}
+
+// awsFilenames - returns the shared credentials and config files, from
+// the environment or in the home directory of the current user if
+// empty. The config file is empty if it cannot be located.
+func awsFilenames(credentialsFile, configFile string) (string, string, error) {
+	homeDir, homeErr := os.UserHomeDir()
+	if credentialsFile == "" {
+		credentialsFile = os.Getenv("AWS_SHARED_CREDENTIALS_FILE")
+		if credentialsFile == "" {
+			if homeErr != nil {
+				return "", "", homeErr
 			}
-			p.Filename = filepath.Join(homeDir, ".aws", "credentials")
+			credentialsFile = filepath.Join(homeDir, ".aws", "credentials")
+		}
+	}
+	if configFile == "" {
+		configFile = os.Getenv("AWS_CONFIG_FILE")
+		if configFile == "" && homeErr == nil {
+			configFile = filepath.Join(homeDir, ".aws", "config")
 		}
 	}
+	return credentialsFile, configFile, nil
+}
+
+func (p *FileAWSCredentials) retrieve() (Value, error) {
+	var err error
+	p.Filename, p.ConfigFilename, err = awsFilenames(p.Filename, p.ConfigFilename)
+	if err != nil {
+		return Value{}, err
+	}
 	if p.Profile == "" {
 		p.Profile = os.Getenv("AWS_PROFILE")
 		if p.Profile == "" {
@@ -90,56 +168,158 @@ func (p *FileAWSCredentials) retrieve() (Value, error) {
 	}
 
 	p.retrieved = false
+	p.fromProcess = false
+	p.expires = false
 
-	iniProfile, err := loadProfile(p.Filename, p.Profile)
+	profile, err := loadAWSProfile(p.Filename, p.ConfigFilename, p.Profile)
 	if err != nil {
 		return Value{}, err
 	}
 
-	// Default to empty string if not found.
-	id := iniProfile.Key("aws_access_key_id")
-	// Default to empty string if not found.
-	secret := iniProfile.Key("aws_secret_access_key")
-	// Default to empty string if not found.
-	token := iniProfile.Key("aws_session_token")
+	value, err := profileCredentials(profile)
+	if err != nil {
+		return Value{}, err
+	}
+	p.retrieved = true
+	p.fromProcess = profile.get("credential_process") != ""
+	if !value.Expiration.IsZero() {
+		p.expires = true
+		p.SetExpiration(value.Expiration, DefaultExpiryWindow)
+	}
+	return value, nil
+}
 
+// profileCredentials - returns the credentials returned by the
+// credential_process of profile if set, its static keys otherwise.
+func profileCredentials(profile awsProfile) (Value, error) {
 	// If credential_process is defined, obtain credentials by executing
 	// the external process
-	credentialProcess := strings.TrimSpace(iniProfile.Key("credential_process").String())
-	if credentialProcess != "" {
-		args := strings.Fields(credentialProcess)
-		if len(args) <= 1 {
-			return Value{}, errors.New("invalid credential process args")
-		}
-		cmd := exec.Command(args[0], args[1:]...)
-		out, err := cmd.Output()
-		if err != nil {
-			return Value{}, err
-		}
-		var externalProcessCredentials externalProcessCredentials
-		err = json.Unmarshal([]byte(out), &externalProcessCredentials)
+	if credentialProcess := profile.get("credential_process"); credentialProcess != "" {
+		creds, err := runCredentialProcess(credentialProcess)
 		if err != nil {
 			return Value{}, err
 		}
-		p.retrieved = true
-		p.SetExpiration(externalProcessCredentials.Expiration, DefaultExpiryWindow)
 		return Value{
-			AccessKeyID:     externalProcessCredentials.AccessKeyID,
-			SecretAccessKey: externalProcessCredentials.SecretAccessKey,
-			SessionToken:    externalProcessCredentials.SessionToken,
-			Expiration:      externalProcessCredentials.Expiration,
+			AccessKeyID:     creds.AccessKeyID,
+			SecretAccessKey: creds.SecretAccessKey,
+			SessionToken:    creds.SessionToken,
+			Expiration:      creds.Expiration,
 			SignerType:      SignatureV4,
 		}, nil
 	}
-	p.retrieved = true
 	return Value{
-		AccessKeyID:     id.String(),
-		SecretAccessKey: secret.String(),
-		SessionToken:    token.String(),
+		// Default to empty string if not found.
+		AccessKeyID:     profile.get("aws_access_key_id"),
+		SecretAccessKey: profile.get("aws_secret_access_key"),
+		SessionToken:    profile.get("aws_session_token"),
 		SignerType:      SignatureV4,
 	}, nil
 }
 
+// runCredentialProcess - runs the command of a credential_process setting
+// and parses the credentials it writes to its standard output.
+func runCredentialProcess(command string) (externalProcessCredentials, error) {
+	var creds externalProcessCredentials
+	args, err := splitCommand(command)
+	if err != nil {
+		return creds, err
+	}
+	if len(args) == 0 {
+		return creds, errors.New("invalid credential process args")
+	}
+	var stderr bytes.Buffer
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return creds, fmt.Errorf("credential process %s: %w: %s", args[0], err, msg)
+		}
+		return creds, fmt.Errorf("credential process %s: %w", args[0], err)
+	}
+	if err = json.Unmarshal(out, &creds); err != nil {
+		return creds, fmt.Errorf("credential process %s: invalid output: %w", args[0], err)
+	}
+	if creds.Version != 1 {
+		return creds, fmt.Errorf("credential process %s: unsupported version %d", args[0], creds.Version)
+	}
+	if creds.AccessKeyID == "" || creds.SecretAccessKey == "" {
+		return creds, fmt.Errorf("credential process %s: missing AccessKeyId or SecretAccessKey", args[0])
+	}
+	return creds, nil
+}
+
+// splitCommand - splits a command line into arguments as a POSIX shell
+// does, without expansions: arguments are separated by blanks, which
+// are kept in single or double quotes or after a backslash.
+func splitCommand(command string) ([]string, error) {
+	var (
+		args  []string
+		arg   strings.Builder
+		inArg bool
+		quote rune
+	)
+	runes := []rune(command)
+	for i := 0; i < len(runes); i++ {
+		c := runes[i]
+		switch {
+		case quote == '\'':
+			if c == '\'' {
+				quote = 0
+			} else {
+				arg.WriteRune(c)
+			}
+		case c == '\\' && (quote == 0 || quote == '"'):
+			if i+1 == len(runes) {
+				return nil, errors.New("invalid credential process args: trailing backslash")
+			}
+			i++
+			// In double quotes, a backslash only escapes the characters
+			// special in them.
+			if quote == '"' && !strings.ContainsRune(`"\$`+"`", runes[i]) {
+				arg.WriteRune(c)
+			}
+			arg.WriteRune(runes[i])
+			inArg = true
+		case quote == '"':
+			if c == '"' {
+				quote = 0
+			} else {
+				arg.WriteRune(c)
+			}
+		case c == '\'' || c == '"':
+			quote = c
+			inArg = true
+		case c == ' ' || c == '\t' || c == '\n':
+			if inArg {
+				args = append(args, arg.String())
+				arg.Reset()
+				inArg = false
+			}
+		default:
+			arg.WriteRune(c)
+			inArg = true
+		}
+	}
+	if quote != 0 {
+		return nil, errors.New("invalid credential process args: unterminated quote")
+	}
+	if inArg {
+		args = append(args, arg.String())
+	}
+	return args, nil
+}
+
+// IsExpired returns if the credentials need to be retrieved again. Keys
+// are read from the files on every retrieval, unless they are returned
+// by a credential_process, which is run again only once they expire.
+func (p *FileAWSCredentials) IsExpired() bool {
+	if !p.retrieved || !p.fromProcess {
+		return true
+	}
+	return p.expires && p.Expiry.IsExpired()
+}
+
 // Retrieve reads and extracts the shared credentials from the current
 // users home directory.
 func (p *FileAWSCredentials) Retrieve() (Value, error) {
@@ -18,10 +18,14 @@
 package credentials
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
 	"runtime"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestFileAWS(t *testing.T) {
@@ -147,6 +151,133 @@ func TestFileAWS(t *testing.T) {
 	}
 }
 
+func TestFileAWSConfig(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("\"/bin/cat\": file does not exist")
+	}
+	dir := t.TempDir()
+	credsFile := filepath.Join(dir, "creds file.json")
+	writeCreds := func(key string, expiration time.Time) {
+		t.Helper()
+		data := fmt.Sprintf(`{"Version": 1, "AccessKeyId": %q, "SecretAccessKey": "secret", "SessionToken": "token"`, key)
+		if !expiration.IsZero() {
+			data += fmt.Sprintf(`, "Expiration": %q`, expiration.Format(time.RFC3339))
+		}
+		if err := os.WriteFile(credsFile, []byte(data+"}"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	configFile := filepath.Join(dir, "config")
+	config := `[default]
+region = us-east-1
+
+[profile process]
+credential_process = /bin/cat '` + credsFile + `'
+
+[profile config_only]
+aws_access_key_id = configKey
+aws_secret_access_key = configSecret
+
+[profile no_token]
+aws_access_key_id = configKey
+aws_session_token = configToken
+
+[profile failing]
+credential_process = /bin/sh -c 'echo denied >&2 && exit 1'
+
+[profile invalid]
+credential_process = /bin/echo '{"Version": 2}'
+`
+	if err := os.WriteFile(configFile, []byte(config), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	os.Clearenv()
+	t.Setenv("AWS_CONFIG_FILE", configFile)
+
+	creds := NewFileAWSCredentials(filepath.Join(dir, "non-existent"), "config_only")
+	credValues, err := creds.GetWithContext(defaultCredContext)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if credValues.AccessKeyID != "configKey" || credValues.SecretAccessKey != "configSecret" {
+		t.Errorf("Expected config keys, got %+v", credValues)
+	}
+
+	// Settings of the credentials file take precedence.
+	credValues, err = NewFileAWSCredentials("credentials.sample", "no_token").GetWithContext(defaultCredContext)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if credValues.AccessKeyID != "accessKey" || credValues.SecretAccessKey != "secret" || credValues.SessionToken != "configToken" {
+		t.Errorf("Unexpected credentials %+v", credValues)
+	}
+
+	// Credentials of a process are kept until they expire.
+	now := time.Now()
+	writeCreds("first", now.Add(time.Hour))
+	p := &FileAWSCredentials{Filename: "credentials.sample", Profile: "process"}
+	p.CurrentTime = func() time.Time { return now }
+	creds = New(p)
+	credValues, err = creds.GetWithContext(defaultCredContext)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if credValues.AccessKeyID != "first" || credValues.SessionToken != "token" || !credValues.Expiration.Equal(now.Add(time.Hour).Truncate(time.Second)) {
+		t.Errorf("Unexpected credentials %+v", credValues)
+	}
+	writeCreds("second", time.Time{})
+	if credValues, _ = creds.GetWithContext(defaultCredContext); credValues.AccessKeyID != "first" {
+		t.Errorf("Expected cached credentials, got %s", credValues.AccessKeyID)
+	}
+	now = now.Add(time.Hour)
+	if credValues, _ = creds.GetWithContext(defaultCredContext); credValues.AccessKeyID != "second" {
+		t.Errorf("Expected refreshed credentials, got %s", credValues.AccessKeyID)
+	}
+	// Credentials without expiration never expire.
+	now = now.Add(24 * time.Hour)
+	if creds.IsExpired() {
+		t.Error("Should not be expired")
+	}
+
+	_, err = NewFileAWSCredentials("credentials.sample", "failing").GetWithContext(defaultCredContext)
+	if err == nil || !strings.Contains(err.Error(), "denied") {
+		t.Errorf("Expected the error of the process, got %v", err)
+	}
+	_, err = NewFileAWSCredentials("credentials.sample", "invalid").GetWithContext(defaultCredContext)
+	if err == nil || !strings.Contains(err.Error(), "unsupported version 2") {
+		t.Errorf("Expected an unsupported version error, got %v", err)
+	}
+}
+
+func TestSplitCommand(t *testing.T) {
+	testCases := []struct {
+		command string
+		args    []string
+		wantErr bool
+	}{
+		{command: "helper", args: []string{"helper"}},
+		{command: "  helper  --profile\tdev ", args: []string{"helper", "--profile", "dev"}},
+		{command: `"/opt/my tools/helper" --name 'a b'`, args: []string{"/opt/my tools/helper", "--name", "a b"}},
+		{command: `helper a\ b "c\"d" "e\f" 'g\h' ""`, args: []string{"helper", "a b", `c"d`, `e\f`, `g\h`, ""}},
+		{command: `helper "unterminated`, wantErr: true},
+		{command: `helper \`, wantErr: true},
+	}
+	for _, testCase := range testCases {
+		args, err := splitCommand(testCase.command)
+		if testCase.wantErr {
+			if err == nil {
+				t.Errorf("%s: expected an error", testCase.command)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", testCase.command, err)
+		} else if !reflect.DeepEqual(args, testCase.args) {
+			t.Errorf("%s: expected %q, got %q", testCase.command, testCase.args, args)
+		}
+	}
+}
+
 func TestFileMinioClient(t *testing.T) {
 	os.Clearenv()
 
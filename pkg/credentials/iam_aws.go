@@ -88,6 +88,19 @@ const (
 	TokenRequestHeader          = "X-aws-ec2-metadata-token"
 )
 
+// stsEndpointForRegion - returns the AWS STS endpoint of region, the
+// global endpoint if empty.
+func stsEndpointForRegion(region string) string {
+	switch {
+	case region == "":
+		return DefaultSTSRoleEndpoint
+	case strings.HasPrefix(region, "cn-"):
+		return "https://sts." + region + ".amazonaws.com.cn"
+	default:
+		return "https://sts." + region + ".amazonaws.com"
+	}
+}
+
 // NewIAM returns a pointer to a new Credentials object wrapping the IAM.
 func NewIAM(endpoint string) *Credentials {
 	return New(&IAM{
@@ -157,15 +170,7 @@ func (m *IAM) RetrieveWithCredContext(cc *CredContext) (Value, error) {
 	switch {
 	case identityFile != "":
 		if len(endpoint) == 0 {
-			if region != "" {
-				if strings.HasPrefix(region, "cn-") {
-					endpoint = "https://sts." + region + ".amazonaws.com.cn"
-				} else {
-					endpoint = "https://sts." + region + ".amazonaws.com"
-				}
-			} else {
-				endpoint = DefaultSTSRoleEndpoint
-			}
+			endpoint = stsEndpointForRegion(region)
 		}
 
 		creds := &STSWebIdentity{
@@ -0,0 +1,232 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package metrics collects request metrics of a minio.Client and
+// writes them in the Prometheus text exposition format.
+//
+//	collector := metrics.NewCollector()
+//	client, err := minio.New(endpoint, &minio.Options{Hooks: collector})
+//	...
+//	http.Handle("/metrics", collector)
+//
+// minio.JoinHooks attaches a collector along with other hooks.
+package metrics
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/minio/minio-go/v7"
+)
+
+// DefaultBuckets are the upper bounds, in seconds, of the latency
+// histogram buckets.
+var DefaultBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60}
+
+// Error code label of failed requests without an S3 error code, e.g.
+// network errors.
+const unknownErrorCode = "Unknown"
+
+// operationStats - the metrics of a single S3 operation.
+type operationStats struct {
+	requests      uint64
+	retries       uint64
+	errors        map[string]uint64
+	bytesSent     uint64
+	bytesReceived uint64
+
+	// Histogram of the request latencies, counts[i] is the number of
+	// requests within buckets[i], the last one counts all others.
+	counts []uint64
+	sum    float64
+}
+
+// Collector records the requests of the clients it is attached to as
+// their minio.Options.Hooks. It is safe for concurrent use and may be
+// shared by several clients.
+type Collector struct {
+	buckets []float64
+
+	mu  sync.Mutex
+	ops map[string]*operationStats
+}
+
+// NewCollector returns a collector using DefaultBuckets for latencies.
+func NewCollector() *Collector {
+	return NewCollectorWithBuckets(DefaultBuckets)
+}
+
+// NewCollectorWithBuckets returns a collector with custom latency
+// histogram buckets, upper bounds in seconds.
+func NewCollectorWithBuckets(buckets []float64) *Collector {
+	buckets = slices.Clone(buckets)
+	slices.Sort(buckets)
+	return &Collector{
+		buckets: slices.Compact(buckets),
+		ops:     make(map[string]*operationStats),
+	}
+}
+
+// stats - returns the metrics of operation, must be called with mu held.
+func (c *Collector) stats(operation string) *operationStats {
+	s, ok := c.ops[operation]
+	if !ok {
+		s = &operationStats{
+			errors: make(map[string]uint64),
+			counts: make([]uint64, len(c.buckets)+1),
+		}
+		c.ops[operation] = s
+	}
+	return s
+}
+
+// RequestStart implements minio.RequestHooks.
+func (c *Collector) RequestStart(ctx context.Context, info minio.RequestInfo) context.Context {
+	return ctx
+}
+
+// RequestRetry implements minio.RequestHooks.
+func (c *Collector) RequestRetry(_ context.Context, info minio.RequestInfo) {
+	c.mu.Lock()
+	c.stats(info.Operation).retries++
+	c.mu.Unlock()
+}
+
+// RequestEnd implements minio.RequestHooks.
+func (c *Collector) RequestEnd(_ context.Context, info minio.RequestInfo) {
+	seconds := info.Duration.Seconds()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := c.stats(info.Operation)
+	s.requests++
+	if info.Err != nil {
+		code := info.ErrorCode
+		if code == "" {
+			code = unknownErrorCode
+		}
+		s.errors[code]++
+	}
+	s.bytesSent += uint64(info.BytesSent)
+	s.bytesReceived += uint64(info.BytesReceived)
+	i, _ := slices.BinarySearch(c.buckets, seconds)
+	s.counts[i]++
+	s.sum += seconds
+}
+
+// WriteTo writes all metrics in the Prometheus text format to w.
+func (c *Collector) WriteTo(w io.Writer) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ops := make([]string, 0, len(c.ops))
+	for op := range c.ops {
+		ops = append(ops, op)
+	}
+	slices.Sort(ops)
+
+	cw := &countingWriter{w: bufio.NewWriter(w)}
+	counter := func(name, help string, value func(s *operationStats) uint64) {
+		cw.printf("# HELP %s %s\n# TYPE %s counter\n", name, help, name)
+		for _, op := range ops {
+			cw.printf("%s{operation=%s} %d\n", name, quote(op), value(c.ops[op]))
+		}
+	}
+
+	counter("minio_client_requests_total", "Total number of requests by S3 operation.",
+		func(s *operationStats) uint64 { return s.requests })
+	counter("minio_client_request_retries_total", "Total number of retried request attempts by S3 operation.",
+		func(s *operationStats) uint64 { return s.retries })
+
+	name := "minio_client_request_errors_total"
+	cw.printf("# HELP %s Total number of failed requests by S3 operation and error code.\n# TYPE %s counter\n", name, name)
+	for _, op := range ops {
+		s := c.ops[op]
+		codes := make([]string, 0, len(s.errors))
+		for code := range s.errors {
+			codes = append(codes, code)
+		}
+		slices.Sort(codes)
+		for _, code := range codes {
+			cw.printf("%s{operation=%s,code=%s} %d\n", name, quote(op), quote(code), s.errors[code])
+		}
+	}
+
+	counter("minio_client_sent_bytes_total", "Total number of bytes sent by S3 operation.",
+		func(s *operationStats) uint64 { return s.bytesSent })
+	counter("minio_client_received_bytes_total", "Total number of bytes received by S3 operation.",
+		func(s *operationStats) uint64 { return s.bytesReceived })
+
+	name = "minio_client_request_duration_seconds"
+	cw.printf("# HELP %s Request latency by S3 operation, including retries.\n# TYPE %s histogram\n", name, name)
+	for _, op := range ops {
+		s := c.ops[op]
+		var count uint64
+		for i, upper := range c.buckets {
+			count += s.counts[i]
+			cw.printf("%s_bucket{operation=%s,le=%s} %d\n", name, quote(op), quote(formatFloat(upper)), count)
+		}
+		count += s.counts[len(c.buckets)]
+		cw.printf("%s_bucket{operation=%s,le=\"+Inf\"} %d\n", name, quote(op), count)
+		cw.printf("%s_sum{operation=%s} %s\n", name, quote(op), formatFloat(s.sum))
+		cw.printf("%s_count{operation=%s} %d\n", name, quote(op), count)
+	}
+
+	if cw.err == nil {
+		cw.err = cw.w.Flush()
+	}
+	return cw.n, cw.err
+}
+
+// ServeHTTP serves the metrics in the Prometheus text format.
+func (c *Collector) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	c.WriteTo(w)
+}
+
+// countingWriter - remembers the first write error and counts the
+// bytes written.
+type countingWriter struct {
+	w   *bufio.Writer
+	n   int64
+	err error
+}
+
+func (cw *countingWriter) printf(format string, args ...any) {
+	if cw.err != nil {
+		return
+	}
+	n, err := fmt.Fprintf(cw.w, format, args...)
+	cw.n += int64(n)
+	cw.err = err
+}
+
+// quote - returns a quoted Prometheus label value.
+func quote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s) + `"`
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
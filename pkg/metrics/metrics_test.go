@@ -0,0 +1,86 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+)
+
+func TestCollector(t *testing.T) {
+	c := NewCollectorWithBuckets([]float64{1, 0.1})
+	ctx := context.Background()
+
+	c.RequestRetry(ctx, minio.RequestInfo{Operation: "PutObject"})
+	c.RequestEnd(ctx, minio.RequestInfo{
+		Operation: "PutObject",
+		BytesSent: 10,
+		Duration:  50 * time.Millisecond,
+	})
+	c.RequestEnd(ctx, minio.RequestInfo{
+		Operation:     "GetObject",
+		BytesReceived: 5,
+		Duration:      500 * time.Millisecond,
+	})
+	c.RequestEnd(ctx, minio.RequestInfo{
+		Operation: "GetObject",
+		ErrorCode: "NoSuchKey",
+		Err:       errors.New("The specified key does not exist."),
+		Duration:  2 * time.Second,
+	})
+	c.RequestEnd(ctx, minio.RequestInfo{
+		Operation: "GetObject",
+		Err:       context.DeadlineExceeded,
+		Duration:  time.Second,
+	})
+
+	var sb strings.Builder
+	n, err := c.WriteTo(&sb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != int64(sb.Len()) {
+		t.Fatalf("Expected %d bytes written, got %d", sb.Len(), n)
+	}
+	for _, line := range []string{
+		`# TYPE minio_client_requests_total counter`,
+		`minio_client_requests_total{operation="GetObject"} 3`,
+		`minio_client_requests_total{operation="PutObject"} 1`,
+		`minio_client_request_retries_total{operation="PutObject"} 1`,
+		`minio_client_request_errors_total{operation="GetObject",code="NoSuchKey"} 1`,
+		`minio_client_request_errors_total{operation="GetObject",code="Unknown"} 1`,
+		`minio_client_sent_bytes_total{operation="PutObject"} 10`,
+		`minio_client_received_bytes_total{operation="GetObject"} 5`,
+		`# TYPE minio_client_request_duration_seconds histogram`,
+		`minio_client_request_duration_seconds_bucket{operation="GetObject",le="0.1"} 0`,
+		`minio_client_request_duration_seconds_bucket{operation="GetObject",le="1"} 2`,
+		`minio_client_request_duration_seconds_bucket{operation="GetObject",le="+Inf"} 3`,
+		`minio_client_request_duration_seconds_sum{operation="GetObject"} 3.5`,
+		`minio_client_request_duration_seconds_count{operation="GetObject"} 3`,
+		`minio_client_request_duration_seconds_bucket{operation="PutObject",le="0.1"} 1`,
+	} {
+		if !strings.Contains(sb.String(), line+"\n") {
+			t.Errorf("Expected line %q in:\n%s", line, sb.String())
+		}
+	}
+}
@@ -0,0 +1,368 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package s3test
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/s3utils"
+)
+
+// Signature V4 constants.
+const (
+	signV4Algorithm       = "AWS4-HMAC-SHA256"
+	iso8601Format         = "20060102T150405Z"
+	unsignedPayload       = "UNSIGNED-PAYLOAD"
+	streamingPayload      = "STREAMING-AWS4-HMAC-SHA256-PAYLOAD"
+	streamingTrailer      = "STREAMING-AWS4-HMAC-SHA256-PAYLOAD-TRAILER"
+	streamingUnsigned     = "STREAMING-UNSIGNED-PAYLOAD-TRAILER"
+	emptySHA256           = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	trailerSignatureKey   = "x-amz-trailer-signature"
+	presignSignatureQuery = "X-Amz-Signature"
+)
+
+// Checksum types verified and stored by the server.
+var checksumTypes = []minio.ChecksumType{
+	minio.ChecksumCRC32,
+	minio.ChecksumCRC32C,
+	minio.ChecksumSHA1,
+	minio.ChecksumSHA256,
+	minio.ChecksumCRC64NVME,
+}
+
+// request - an authenticated request.
+type request struct {
+	*http.Request
+	bucket string
+	object string
+
+	// Signing state of streaming payloads.
+	signingKey    []byte
+	amzDate       string
+	scope         string
+	seedSignature string
+}
+
+// payload - the decoded and verified body of a request.
+type payload struct {
+	data []byte
+	// Checksum sent with the request, if any.
+	checksumType minio.ChecksumType
+	checksum     string
+}
+
+// authenticate - verifies the signature V4 of r, either in the
+// Authorization header or in the query of a presigned URL.
+func (s *Server) authenticate(r *http.Request) (*request, error) {
+	req := &request{Request: r}
+	req.bucket, req.object, _ = strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+
+	q := r.URL.Query()
+	var credential, signedHeaders, signature, hashedPayload string
+	switch auth := r.Header.Get("Authorization"); {
+	case strings.HasPrefix(auth, signV4Algorithm+" "):
+		for _, field := range strings.Split(strings.TrimPrefix(auth, signV4Algorithm+" "), ",") {
+			key, value, _ := strings.Cut(strings.TrimSpace(field), "=")
+			switch key {
+			case "Credential":
+				credential = value
+			case "SignedHeaders":
+				signedHeaders = value
+			case "Signature":
+				signature = value
+			}
+		}
+		req.amzDate = r.Header.Get("X-Amz-Date")
+		hashedPayload = r.Header.Get("X-Amz-Content-Sha256")
+		if hashedPayload == "" {
+			return nil, errContentSHA256Mismatch
+		}
+	case auth != "":
+		return nil, errUnsupportedSignature
+	case q.Get("X-Amz-Algorithm") == signV4Algorithm:
+		credential = q.Get("X-Amz-Credential")
+		signedHeaders = q.Get("X-Amz-SignedHeaders")
+		signature = q.Get(presignSignatureQuery)
+		req.amzDate = q.Get("X-Amz-Date")
+		hashedPayload = unsignedPayload
+		if v := q.Get("X-Amz-Content-Sha256"); v != "" {
+			hashedPayload = v
+		}
+		date, err := time.Parse(iso8601Format, req.amzDate)
+		if err != nil {
+			return nil, errMalformedAuthorization
+		}
+		expires, err := strconv.ParseInt(q.Get("X-Amz-Expires"), 10, 64)
+		if err != nil {
+			return nil, errMalformedAuthorization
+		}
+		if time.Now().After(date.Add(time.Duration(expires) * time.Second)) {
+			return nil, errExpiredPresign
+		}
+		q.Del(presignSignatureQuery)
+	case q.Has("X-Amz-Credential") || q.Has("Signature"):
+		return nil, errUnsupportedSignature
+	default:
+		return nil, errMissingAuth
+	}
+
+	// Credential is <access key>/<date>/<region>/s3/aws4_request.
+	scope := strings.Split(credential, "/")
+	if len(scope) != 5 || scope[3] != "s3" || scope[4] != "aws4_request" {
+		return nil, errMalformedAuthorization
+	}
+	if scope[0] != s.accessKey {
+		return nil, errInvalidAccessKeyID
+	}
+	if scope[2] != s.region {
+		err := *errMalformedAuthorization
+		err.message = "The authorization header is malformed; the region '" + scope[2] + "' is wrong; expecting '" + s.region + "'"
+		err.region = s.region
+		return nil, &err
+	}
+	if !strings.HasPrefix(req.amzDate, scope[1]) {
+		return nil, errMalformedAuthorization
+	}
+	req.scope = strings.Join(scope[1:], "/")
+	req.signingKey = signingKey(s.secretKey, scope[1], scope[2])
+
+	canonicalRequest := strings.Join([]string{
+		r.Method,
+		s3utils.EncodePath(r.URL.Path),
+		strings.ReplaceAll(q.Encode(), "+", "%20"),
+		canonicalHeaders(r, strings.Split(signedHeaders, ";")),
+		signedHeaders,
+		hashedPayload,
+	}, "\n")
+	stringToSign := strings.Join([]string{
+		signV4Algorithm,
+		req.amzDate,
+		req.scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+	if !hmac.Equal([]byte(signature), []byte(hmacHex(req.signingKey, stringToSign))) {
+		return nil, errSignatureDoesNotMatch
+	}
+	req.seedSignature = signature
+	return req, nil
+}
+
+// canonicalHeaders - returns the canonical form of the signed headers
+// of r.
+func canonicalHeaders(r *http.Request, signed []string) string {
+	var buf strings.Builder
+	for _, name := range signed {
+		var values []string
+		switch name {
+		case "host":
+			values = []string{r.Host}
+		case "content-length":
+			values = []string{strconv.FormatInt(r.ContentLength, 10)}
+		case "transfer-encoding":
+			values = r.TransferEncoding
+		default:
+			values = r.Header.Values(name)
+		}
+		buf.WriteString(name)
+		buf.WriteByte(':')
+		for i, v := range values {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			buf.WriteString(strings.Join(strings.Fields(v), " "))
+		}
+		buf.WriteByte('\n')
+	}
+	return buf.String()
+}
+
+// signingKey - derives the signature V4 key of a day and region.
+func signingKey(secret, date, region string) []byte {
+	key := hmacSum([]byte("AWS4"+secret), date)
+	key = hmacSum(key, region)
+	key = hmacSum(key, "s3")
+	return hmacSum(key, "aws4_request")
+}
+
+func hmacSum(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+func hmacHex(key []byte, data string) string {
+	return hex.EncodeToString(hmacSum(key, data))
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// body - reads, decodes and verifies the payload of r against its
+// signature, Content-MD5 and checksum.
+func (r *request) body() (*payload, error) {
+	raw, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &payload{data: raw}
+	var trailer http.Header
+	switch hashed := r.Header.Get("X-Amz-Content-Sha256"); hashed {
+	case streamingPayload, streamingTrailer, streamingUnsigned:
+		if p.data, trailer, err = r.decodeChunked(raw, hashed != streamingUnsigned); err != nil {
+			return nil, err
+		}
+		if size := r.Header.Get("X-Amz-Decoded-Content-Length"); size != "" && size != strconv.Itoa(len(p.data)) {
+			return nil, errIncompleteBody
+		}
+	case "", unsignedPayload:
+	default:
+		if sha256Hex(raw) != hashed {
+			return nil, errContentSHA256Mismatch
+		}
+	}
+
+	if contentMD5 := r.Header.Get("Content-Md5"); contentMD5 != "" {
+		want, err := base64.StdEncoding.DecodeString(contentMD5)
+		if err != nil || len(want) != md5.Size {
+			return nil, errInvalidDigest
+		}
+		if got := md5.Sum(p.data); !bytes.Equal(got[:], want) {
+			return nil, errBadDigest
+		}
+	}
+
+	// The checksum headers of CompleteMultipartUpload describe the
+	// object, they are verified on completion.
+	if r.Method == http.MethodPost && r.URL.Query().Has("uploadId") {
+		return p, nil
+	}
+	for _, t := range checksumTypes {
+		value := r.Header.Get(t.Key())
+		if value == "" {
+			value = trailer.Get(t.Key())
+		}
+		if value == "" {
+			continue
+		}
+		if t.EncodeToString(p.data) != value {
+			return nil, errBadDigest
+		}
+		p.checksumType, p.checksum = t, value
+	}
+	return p, nil
+}
+
+// decodeChunked - decodes an aws-chunked payload, verifying the chunk
+// and trailer signatures if signed.
+func (r *request) decodeChunked(raw []byte, signed bool) ([]byte, http.Header, error) {
+	br := bufio.NewReader(bytes.NewReader(raw))
+	prevSignature := r.seedSignature
+	var data []byte
+	for {
+		line, err := br.ReadString('\n')
+		if err != nil {
+			return nil, nil, errIncompleteBody
+		}
+		sizeHex, params, _ := strings.Cut(strings.TrimRight(line, "\r\n"), ";")
+		size, err := strconv.ParseInt(sizeHex, 16, 64)
+		if err != nil || size < 0 || size > int64(len(raw)) {
+			return nil, nil, errIncompleteBody
+		}
+		chunk := make([]byte, size)
+		if _, err = io.ReadFull(br, chunk); err != nil {
+			return nil, nil, errIncompleteBody
+		}
+		if signed {
+			signature := strings.TrimPrefix(params, "chunk-signature=")
+			stringToSign := strings.Join([]string{
+				"AWS4-HMAC-SHA256-PAYLOAD",
+				r.amzDate,
+				r.scope,
+				prevSignature,
+				emptySHA256,
+				sha256Hex(chunk),
+			}, "\n")
+			if !hmac.Equal([]byte(signature), []byte(hmacHex(r.signingKey, stringToSign))) {
+				return nil, nil, errSignatureDoesNotMatch
+			}
+			prevSignature = signature
+		}
+		if size == 0 {
+			break
+		}
+		data = append(data, chunk...)
+		if crlf, err := br.ReadString('\n'); err != nil || crlf != "\r\n" {
+			return nil, nil, errIncompleteBody
+		}
+	}
+
+	// Trailers follow the last chunk as "key:value" lines.
+	trailer := make(http.Header)
+	var signedTrailer []byte
+	var trailerSignature string
+	for {
+		line, err := br.ReadString('\n')
+		if kv := strings.TrimRight(line, "\r\n"); kv != "" {
+			key, value, _ := strings.Cut(kv, ":")
+			if key == trailerSignatureKey {
+				trailerSignature = value
+			} else {
+				trailer.Set(key, value)
+				signedTrailer = append(signedTrailer, kv+"\n"...)
+			}
+		}
+		if err != nil {
+			break
+		}
+	}
+	declared := r.Header.Values("X-Amz-Trailer")
+	for key := range trailer {
+		if !slices.Contains(declared, strings.ToLower(key)) {
+			return nil, nil, errInvalidArgument
+		}
+	}
+	if signed && len(trailer) > 0 {
+		stringToSign := strings.Join([]string{
+			"AWS4-HMAC-SHA256-TRAILER",
+			r.amzDate,
+			r.scope,
+			prevSignature,
+			sha256Hex(signedTrailer),
+		}, "\n")
+		if !hmac.Equal([]byte(trailerSignature), []byte(hmacHex(r.signingKey, stringToSign))) {
+			return nil, nil, errSignatureDoesNotMatch
+		}
+	}
+	return data, trailer, nil
+}
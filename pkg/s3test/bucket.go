@@ -0,0 +1,351 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package s3test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/s3utils"
+	"github.com/minio/minio-go/v7/pkg/tags"
+)
+
+// Versioning states of a bucket.
+const (
+	versioningEnabled   = "Enabled"
+	versioningSuspended = "Suspended"
+)
+
+// bucket - the state of a bucket, guarded by Server.mu.
+type bucket struct {
+	name    string
+	created time.Time
+
+	// Empty if versioning was never configured.
+	versioning string
+
+	objectLock       bool
+	objectLockConfig []byte
+
+	tags *tags.Tags
+
+	objects map[string]*object
+	uploads map[string]*multipartUpload
+}
+
+// object - all versions of an object, oldest first.
+type object struct {
+	versions []*objectVersion
+}
+
+// latest - returns the current version of the object.
+func (o *object) latest() *objectVersion {
+	return o.versions[len(o.versions)-1]
+}
+
+// version - returns the version with id, the latest one if id is
+// empty.
+func (o *object) version(id string) *objectVersion {
+	if id == "" {
+		return o.latest()
+	}
+	for _, v := range o.versions {
+		if v.versionID == id {
+			return v
+		}
+	}
+	return nil
+}
+
+// lockBucket - returns the bucket of r, Server.mu is held on success.
+func (s *Server) lockBucket(r *request) (*bucket, error) {
+	s.mu.Lock()
+	b, ok := s.buckets[r.bucket]
+	if !ok {
+		s.mu.Unlock()
+		return nil, errNoSuchBucket
+	}
+	return b, nil
+}
+
+// listAllMyBucketsResult - the response of ListBuckets.
+type listAllMyBucketsResult struct {
+	XMLName xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ ListAllMyBucketsResult"`
+	Owner   owner
+	Buckets struct {
+		Bucket []bucketInfo
+	}
+}
+
+type owner struct {
+	ID          string
+	DisplayName string
+}
+
+type bucketInfo struct {
+	Name         string
+	CreationDate string
+}
+
+func (s *Server) listBuckets(w http.ResponseWriter, _ *request) {
+	s.mu.Lock()
+	result := listAllMyBucketsResult{Owner: owner{ID: s.accessKey, DisplayName: s.accessKey}}
+	for _, b := range s.buckets {
+		result.Buckets.Bucket = append(result.Buckets.Bucket, bucketInfo{
+			Name:         b.name,
+			CreationDate: b.created.Format(time.RFC3339Nano),
+		})
+	}
+	s.mu.Unlock()
+	slices.SortFunc(result.Buckets.Bucket, func(a, b bucketInfo) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	writeXML(w, http.StatusOK, result)
+}
+
+type createBucketConfiguration struct {
+	Location string `xml:"LocationConstraint"`
+}
+
+func (s *Server) makeBucket(w http.ResponseWriter, r *request) {
+	if err := s3utils.CheckValidBucketNameStrict(r.bucket); err != nil {
+		s.writeError(w, r.Request, errInvalidBucketName)
+		return
+	}
+	p, err := r.body()
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	if len(p.data) > 0 {
+		var config createBucketConfiguration
+		if err = xml.Unmarshal(p.data, &config); err != nil {
+			s.writeError(w, r.Request, errMalformedXML)
+			return
+		}
+		if config.Location != "" && config.Location != s.region {
+			s.writeError(w, r.Request, &apiError{http.StatusBadRequest, "InvalidLocationConstraint", "The specified location-constraint is not valid.", s.region})
+			return
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.buckets[r.bucket]; ok {
+		s.writeError(w, r.Request, errBucketAlreadyOwned)
+		return
+	}
+	b := &bucket{
+		name:    r.bucket,
+		created: time.Now().UTC(),
+		objects: make(map[string]*object),
+		uploads: make(map[string]*multipartUpload),
+	}
+	if strings.EqualFold(r.Header.Get("X-Amz-Bucket-Object-Lock-Enabled"), "true") {
+		b.objectLock = true
+		b.versioning = versioningEnabled
+	}
+	s.buckets[r.bucket] = b
+	w.Header().Set("Location", "/"+r.bucket)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) headBucket(w http.ResponseWriter, r *request) {
+	if _, err := s.lockBucket(r); err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	s.mu.Unlock()
+	w.Header().Set("X-Amz-Bucket-Region", s.region)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) deleteBucket(w http.ResponseWriter, r *request) {
+	b, err := s.lockBucket(r)
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	defer s.mu.Unlock()
+	if len(b.objects) > 0 {
+		s.writeError(w, r.Request, errBucketNotEmpty)
+		return
+	}
+	delete(s.buckets, r.bucket)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type locationConstraint struct {
+	XMLName  xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ LocationConstraint"`
+	Location string   `xml:",chardata"`
+}
+
+func (s *Server) getBucketLocation(w http.ResponseWriter, r *request) {
+	if _, err := s.lockBucket(r); err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	s.mu.Unlock()
+	location := s.region
+	if location == DefaultRegion {
+		location = ""
+	}
+	writeXML(w, http.StatusOK, locationConstraint{Location: location})
+}
+
+type versioningConfiguration struct {
+	XMLName xml.Name `xml:"VersioningConfiguration"`
+	Status  string   `xml:"Status,omitempty"`
+}
+
+func (s *Server) putBucketVersioning(w http.ResponseWriter, r *request) {
+	p, err := r.body()
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	var config versioningConfiguration
+	if err = xml.Unmarshal(p.data, &config); err != nil ||
+		(config.Status != versioningEnabled && config.Status != versioningSuspended) {
+		s.writeError(w, r.Request, errMalformedXML)
+		return
+	}
+	b, err := s.lockBucket(r)
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	defer s.mu.Unlock()
+	if b.objectLock && config.Status == versioningSuspended {
+		s.writeError(w, r.Request, errInvalidBucketState)
+		return
+	}
+	b.versioning = config.Status
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) getBucketVersioning(w http.ResponseWriter, r *request) {
+	b, err := s.lockBucket(r)
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	config := versioningConfiguration{Status: b.versioning}
+	s.mu.Unlock()
+	writeXML(w, http.StatusOK, config)
+}
+
+type objectLockConfiguration struct {
+	XMLName           xml.Name `xml:"ObjectLockConfiguration"`
+	ObjectLockEnabled string
+}
+
+func (s *Server) putObjectLockConfig(w http.ResponseWriter, r *request) {
+	p, err := r.body()
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	var config objectLockConfiguration
+	if err = xml.Unmarshal(p.data, &config); err != nil || config.ObjectLockEnabled != "Enabled" {
+		s.writeError(w, r.Request, errMalformedXML)
+		return
+	}
+	b, err := s.lockBucket(r)
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	defer s.mu.Unlock()
+	if !b.objectLock {
+		s.writeError(w, r.Request, errInvalidBucketState)
+		return
+	}
+	b.objectLockConfig = p.data
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) getObjectLockConfig(w http.ResponseWriter, r *request) {
+	b, err := s.lockBucket(r)
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	enabled, config := b.objectLock, b.objectLockConfig
+	s.mu.Unlock()
+	switch {
+	case !enabled:
+		s.writeError(w, r.Request, errNoSuchLockConfig)
+	case config == nil:
+		writeXML(w, http.StatusOK, objectLockConfiguration{ObjectLockEnabled: "Enabled"})
+	default:
+		w.Header().Set("Content-Type", "application/xml")
+		w.Write(config)
+	}
+}
+
+func (s *Server) putBucketTagging(w http.ResponseWriter, r *request) {
+	p, err := r.body()
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	t, err := tags.ParseBucketXML(bytes.NewReader(p.data))
+	if err != nil {
+		s.writeError(w, r.Request, &apiError{http.StatusBadRequest, "InvalidTag", err.Error(), ""})
+		return
+	}
+	b, err := s.lockBucket(r)
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	b.tags = t
+	s.mu.Unlock()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) getBucketTagging(w http.ResponseWriter, r *request) {
+	b, err := s.lockBucket(r)
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	t := b.tags
+	s.mu.Unlock()
+	if t == nil {
+		s.writeError(w, r.Request, errNoSuchTagSet)
+		return
+	}
+	writeXML(w, http.StatusOK, t)
+}
+
+func (s *Server) deleteBucketTagging(w http.ResponseWriter, r *request) {
+	b, err := s.lockBucket(r)
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	b.tags = nil
+	s.mu.Unlock()
+	w.WriteHeader(http.StatusNoContent)
+}
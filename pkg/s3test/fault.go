@@ -0,0 +1,266 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package s3test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// FaultKind is the kind of failure injected by a Fault.
+type FaultKind int
+
+// Kinds of faults.
+const (
+	// FaultErrorResponse answers with Fault.StatusCode, 500 by
+	// default, and an S3 error of Fault.Code if set. The request is not
+	// sent.
+	FaultErrorResponse FaultKind = iota + 1
+
+	// FaultOKWithError answers 200 OK with an S3 error body of
+	// Fault.Code, InternalError by default, as S3 may do for
+	// CompleteMultipartUpload and other long running requests. The
+	// request is not sent.
+	FaultOKWithError
+
+	// FaultConnectionReset fails the request with a connection reset
+	// error. The request is not sent.
+	FaultConnectionReset
+
+	// FaultTruncatedBody cuts the response body after
+	// Fault.TruncateAt bytes, half of the body by default, and fails
+	// its reads with io.ErrUnexpectedEOF.
+	FaultTruncatedBody
+
+	// FaultDelayedHeaders returns the response after Fault.Delay, or
+	// fails when the request context is done first.
+	FaultDelayedHeaders
+)
+
+// Fault describes failures injected by a FaultTransport.
+type Fault struct {
+	Kind FaultKind
+
+	// Match selects the requests the fault applies to, all requests
+	// if nil.
+	Match func(*http.Request) bool
+
+	// Rate is the probability that a matching request fails, in
+	// (0, 1]. Zero fails every matching request.
+	Rate float64
+
+	// Count limits the number of injected failures, unlimited if zero.
+	Count int
+
+	// StatusCode, Code and Message of error responses.
+	StatusCode int
+	Code       string
+	Message    string
+
+	// TruncateAt is the length of truncated bodies.
+	TruncateAt int64
+
+	// Delay of delayed responses.
+	Delay time.Duration
+}
+
+// FaultTransport is an http.RoundTripper injecting faults into the
+// requests sent through it, to exercise retries and error handling
+// without a flaky server. The first fault matching a request, if any,
+// is injected. Random rates are seeded, so runs are reproducible.
+type FaultTransport struct {
+	next http.RoundTripper
+
+	mu       sync.Mutex
+	rand     *rand.Rand
+	faults   []Fault
+	injected []int
+}
+
+// NewFaultTransport returns a FaultTransport sending requests with
+// next, http.DefaultTransport if nil, and injecting faults.
+func NewFaultTransport(next http.RoundTripper, faults ...Fault) *FaultTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &FaultTransport{
+		next:     next,
+		rand:     rand.New(rand.NewPCG(1, 2)),
+		faults:   faults,
+		injected: make([]int, len(faults)),
+	}
+}
+
+// Injected returns the number of faults injected so far.
+func (t *FaultTransport) Injected() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	n := 0
+	for _, injected := range t.injected {
+		n += injected
+	}
+	return n
+}
+
+// pick - returns the fault to inject into r, nil if none.
+func (t *FaultTransport) pick(r *http.Request) *Fault {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for i := range t.faults {
+		f := &t.faults[i]
+		if f.Count > 0 && t.injected[i] >= f.Count {
+			continue
+		}
+		if f.Match != nil && !f.Match(r) {
+			continue
+		}
+		if f.Rate > 0 && t.rand.Float64() >= f.Rate {
+			continue
+		}
+		t.injected[i]++
+		return f
+	}
+	return nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *FaultTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	f := t.pick(r)
+	if f == nil {
+		return t.next.RoundTrip(r)
+	}
+
+	switch f.Kind {
+	case FaultTruncatedBody, FaultDelayedHeaders:
+	default:
+		// Requests that are not sent are consumed as a real transport
+		// would.
+		if r.Body != nil {
+			io.Copy(io.Discard, r.Body)
+			r.Body.Close()
+		}
+	}
+
+	switch f.Kind {
+	case FaultErrorResponse:
+		status := f.StatusCode
+		if status == 0 {
+			status = http.StatusInternalServerError
+		}
+		return faultResponse(r, status, f.Code, f.Message), nil
+	case FaultOKWithError:
+		code := f.Code
+		if code == "" {
+			code = "InternalError"
+		}
+		return faultResponse(r, http.StatusOK, code, f.Message), nil
+	case FaultConnectionReset:
+		return nil, &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}
+	case FaultTruncatedBody:
+		resp, err := t.next.RoundTrip(r)
+		if err != nil {
+			return nil, err
+		}
+		n := f.TruncateAt
+		if n <= 0 {
+			n = max(resp.ContentLength/2, 0)
+		}
+		resp.Body = &truncatedBody{body: resp.Body, remaining: n}
+		return resp, nil
+	case FaultDelayedHeaders:
+		resp, err := t.next.RoundTrip(r)
+		if err != nil {
+			return nil, err
+		}
+		timer := time.NewTimer(f.Delay)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+			return resp, nil
+		case <-r.Context().Done():
+			resp.Body.Close()
+			return nil, r.Context().Err()
+		}
+	default:
+		return t.next.RoundTrip(r)
+	}
+}
+
+// faultResponse - returns a response of status with an S3 error of code
+// as body, if set.
+func faultResponse(r *http.Request, status int, code, message string) *http.Response {
+	var body []byte
+	header := make(http.Header)
+	if code != "" {
+		if message == "" {
+			message = "Injected fault."
+		}
+		b, _ := xml.Marshal(errorResponse{
+			Code:      code,
+			Message:   message,
+			Resource:  r.URL.Path,
+			RequestID: "fault",
+		})
+		body = append([]byte(xml.Header), b...)
+		header.Set("Content-Type", "application/xml")
+	}
+	header.Set("Content-Length", strconv.Itoa(len(body)))
+	header.Set("x-amz-request-id", "fault")
+	return &http.Response{
+		Status:        strconv.Itoa(status) + " " + http.StatusText(status),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       r,
+	}
+}
+
+// truncatedBody - a response body cut after remaining bytes.
+type truncatedBody struct {
+	body      io.ReadCloser
+	remaining int64
+}
+
+func (b *truncatedBody) Read(p []byte) (int, error) {
+	if b.remaining <= 0 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if int64(len(p)) > b.remaining {
+		p = p[:b.remaining]
+	}
+	n, err := b.body.Read(p)
+	b.remaining -= int64(n)
+	return n, err
+}
+
+func (b *truncatedBody) Close() error {
+	return b.body.Close()
+}
@@ -0,0 +1,174 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package s3test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// newFaultClient - returns a client injecting faults into the requests
+// to a new server with a bucket.
+func newFaultClient(t *testing.T, opts *minio.Options, faults ...Fault) (*minio.Client, *FaultTransport) {
+	t.Helper()
+	srv, client := newTestClient(t, nil)
+	if err := client.MakeBucket(context.Background(), "bucket", minio.MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	tr := NewFaultTransport(srv.srv.Client().Transport, faults...)
+	opts.Creds = credentials.NewStaticV4(DefaultAccessKey, DefaultSecretKey, "")
+	opts.Region = DefaultRegion
+	opts.Transport = tr
+	if opts.RetryPolicy == nil {
+		opts.RetryPolicy = minio.DefaultRetryPolicy{BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+	}
+	client, err := minio.New(srv.Endpoint(), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, tr
+}
+
+func matchMethod(method string) func(*http.Request) bool {
+	return func(r *http.Request) bool {
+		return r.Method == method
+	}
+}
+
+func TestFaultTransport(t *testing.T) {
+	data := bytes.Repeat([]byte("0123456789"), 1000)
+	testCases := []struct {
+		name    string
+		opts    minio.Options
+		fault   Fault
+		wantErr string
+	}{
+		{
+			name:  "SlowDown",
+			fault: Fault{Kind: FaultErrorResponse, StatusCode: http.StatusServiceUnavailable, Code: "SlowDown", Count: 3},
+		},
+		{
+			name:  "InternalError",
+			fault: Fault{Kind: FaultErrorResponse, Code: "InternalError", Count: 1},
+		},
+		{
+			name:  "BadGateway",
+			fault: Fault{Kind: FaultErrorResponse, StatusCode: http.StatusBadGateway, Count: 1},
+		},
+		{
+			name:    "AccessDenied",
+			fault:   Fault{Kind: FaultErrorResponse, StatusCode: http.StatusForbidden, Code: "AccessDenied", Match: matchMethod(http.MethodGet)},
+			wantErr: "AccessDenied",
+		},
+		{
+			name:  "ConnectionReset",
+			fault: Fault{Kind: FaultConnectionReset, Count: 2},
+		},
+		{
+			name:  "TruncatedBody",
+			fault: Fault{Kind: FaultTruncatedBody, Match: matchMethod(http.MethodGet), Count: 2},
+		},
+		{
+			name:  "DelayedHeaders",
+			opts:  minio.Options{AttemptTimeout: 50 * time.Millisecond},
+			fault: Fault{Kind: FaultDelayedHeaders, Delay: time.Second, Count: 1},
+		},
+		{
+			name:    "Exhausted",
+			opts:    minio.Options{RetryPolicy: minio.DefaultRetryPolicy{MaxRetries: 2, BaseDelay: time.Millisecond}},
+			fault:   Fault{Kind: FaultErrorResponse, StatusCode: http.StatusServiceUnavailable, Code: "SlowDown", Match: matchMethod(http.MethodGet)},
+			wantErr: "SlowDown",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := context.Background()
+			client, tr := newFaultClient(t, &testCase.opts, testCase.fault)
+
+			_, err := client.PutObject(ctx, "bucket", "object", bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{})
+			if err == nil {
+				var obj *minio.Object
+				if obj, err = client.GetObject(ctx, "bucket", "object", minio.GetObjectOptions{}); err == nil {
+					var buf bytes.Buffer
+					if _, err = buf.ReadFrom(obj); err == nil && !bytes.Equal(buf.Bytes(), data) {
+						t.Fatal("unexpected object data")
+					}
+					obj.Close()
+				}
+			}
+			if testCase.wantErr == "" && err != nil {
+				t.Fatal(err)
+			}
+			if testCase.wantErr != "" && minio.ToErrorResponse(err).Code != testCase.wantErr {
+				t.Fatalf("expected %s, got %v", testCase.wantErr, err)
+			}
+			if tr.Injected() == 0 {
+				t.Fatal("expected faults to be injected")
+			}
+		})
+	}
+}
+
+func TestFaultOKWithError(t *testing.T) {
+	complete := func(r *http.Request) bool {
+		return r.Method == http.MethodPost && r.URL.Query().Has("uploadId")
+	}
+	client, tr := newFaultClient(t, &minio.Options{}, Fault{Kind: FaultOKWithError, Match: complete, Count: 2})
+	data := bytes.Repeat([]byte("x"), 6<<20)
+	info, err := client.PutObject(context.Background(), "bucket", "object", bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{PartSize: 5 << 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tr.Injected() != 2 || info.Size != int64(len(data)) {
+		t.Fatalf("unexpected upload %+v after %d faults", info, tr.Injected())
+	}
+
+	// Not retried with a non-retryable code.
+	client, tr = newFaultClient(t, &minio.Options{}, Fault{Kind: FaultOKWithError, Match: complete, Code: "InvalidPart"})
+	_, err = client.PutObject(context.Background(), "bucket", "object", bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{PartSize: 5 << 20})
+	if minio.ToErrorResponse(err).Code != "InvalidPart" || tr.Injected() != 1 {
+		t.Fatalf("expected InvalidPart once, got %v after %d faults", err, tr.Injected())
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestFaultRate(t *testing.T) {
+	tr := NewFaultTransport(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		return faultResponse(r, http.StatusOK, "", ""), nil
+	}), Fault{Kind: FaultErrorResponse, Rate: 0.25})
+	for range 1000 {
+		req, _ := http.NewRequest(http.MethodGet, "http://localhost/bucket/object", nil)
+		if _, err := tr.RoundTrip(req); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := tr.Injected(); n < 200 || n > 300 {
+		t.Fatalf("expected about 250 faults, got %d", n)
+	}
+}
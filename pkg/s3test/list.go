@@ -0,0 +1,331 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package s3test
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Default and maximum number of keys of a listing.
+const maxListKeys = 1000
+
+type commonPrefix struct {
+	Prefix string
+}
+
+type objectEntry struct {
+	Key          string
+	LastModified string
+	ETag         string
+	Size         int64
+	StorageClass string
+	Owner        *owner `xml:",omitempty"`
+}
+
+// listBucketResult - the response of ListObjects and ListObjectsV2.
+type listBucketResult struct {
+	XMLName               xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ ListBucketResult"`
+	Name                  string
+	Prefix                string
+	Marker                string `xml:",omitempty"`
+	NextMarker            string `xml:",omitempty"`
+	StartAfter            string `xml:",omitempty"`
+	ContinuationToken     string `xml:",omitempty"`
+	NextContinuationToken string `xml:",omitempty"`
+	KeyCount              *int   `xml:",omitempty"`
+	MaxKeys               int
+	Delimiter             string `xml:",omitempty"`
+	IsTruncated           bool
+	Contents              []objectEntry
+	CommonPrefixes        []commonPrefix
+}
+
+// listParams - returns the prefix, delimiter and max keys of a listing.
+func listParams(r *request) (prefix, delimiter string, maxKeys int, err error) {
+	q := r.URL.Query()
+	maxKeys = maxListKeys
+	if v := q.Get("max-keys"); v != "" {
+		if maxKeys, err = strconv.Atoi(v); err != nil || maxKeys < 0 {
+			return "", "", 0, errInvalidArgument
+		}
+		maxKeys = min(maxKeys, maxListKeys)
+	}
+	return q.Get("prefix"), q.Get("delimiter"), maxKeys, nil
+}
+
+// sortedKeys - returns the keys of b in lexical order.
+func (b *bucket) sortedKeys() []string {
+	keys := make([]string, 0, len(b.objects))
+	for key := range b.objects {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// commonPrefixOf - returns the common prefix of key for a listing of
+// prefix and delimiter, empty if key is listed itself.
+func commonPrefixOf(key, prefix, delimiter string) string {
+	if delimiter == "" {
+		return ""
+	}
+	if i := strings.Index(key[len(prefix):], delimiter); i >= 0 {
+		return key[:len(prefix)+i+len(delimiter)]
+	}
+	return ""
+}
+
+// listCurrent - lists the current versions of the objects of b after
+// marker, returning the marker of the next page if truncated.
+func (b *bucket) listCurrent(prefix, delimiter, marker string, maxKeys int, withOwner *owner) (contents []objectEntry, prefixes []commonPrefix, next string) {
+	for _, key := range b.sortedKeys() {
+		if !strings.HasPrefix(key, prefix) || key <= marker {
+			continue
+		}
+		v := b.objects[key].latest()
+		if v.deleteMarker {
+			continue
+		}
+		entry := key
+		cp := commonPrefixOf(key, prefix, delimiter)
+		if cp != "" {
+			if cp <= marker || (len(prefixes) > 0 && prefixes[len(prefixes)-1].Prefix == cp) {
+				continue
+			}
+			entry = cp
+		}
+		if len(contents)+len(prefixes) == maxKeys {
+			return contents, prefixes, next
+		}
+		if cp != "" {
+			prefixes = append(prefixes, commonPrefix{Prefix: cp})
+		} else {
+			contents = append(contents, objectEntry{
+				Key:          key,
+				LastModified: v.modTime.Format(time.RFC3339Nano),
+				ETag:         quoteETag(v.etag),
+				Size:         int64(len(v.data)),
+				StorageClass: "STANDARD",
+				Owner:        withOwner,
+			})
+		}
+		next = entry
+	}
+	return contents, prefixes, ""
+}
+
+func (s *Server) listObjects(w http.ResponseWriter, r *request) {
+	prefix, delimiter, maxKeys, err := listParams(r)
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	marker := r.URL.Query().Get("marker")
+
+	b, err := s.lockBucket(r)
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	contents, prefixes, next := b.listCurrent(prefix, delimiter, marker, maxKeys, nil)
+	s.mu.Unlock()
+
+	result := listBucketResult{
+		Name:           r.bucket,
+		Prefix:         prefix,
+		Marker:         marker,
+		MaxKeys:        maxKeys,
+		Delimiter:      delimiter,
+		IsTruncated:    next != "",
+		Contents:       contents,
+		CommonPrefixes: prefixes,
+	}
+	if delimiter != "" {
+		result.NextMarker = next
+	}
+	writeXML(w, http.StatusOK, result)
+}
+
+func (s *Server) listObjectsV2(w http.ResponseWriter, r *request) {
+	prefix, delimiter, maxKeys, err := listParams(r)
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	q := r.URL.Query()
+	marker := q.Get("start-after")
+	token := q.Get("continuation-token")
+	if token != "" {
+		decoded, err := base64.RawURLEncoding.DecodeString(token)
+		if err != nil {
+			s.writeError(w, r.Request, &apiError{http.StatusBadRequest, "InvalidArgument", "The continuation token provided is incorrect.", ""})
+			return
+		}
+		marker = string(decoded)
+	}
+	var withOwner *owner
+	if q.Get("fetch-owner") == "true" {
+		withOwner = &owner{ID: s.accessKey, DisplayName: s.accessKey}
+	}
+
+	b, err := s.lockBucket(r)
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	contents, prefixes, next := b.listCurrent(prefix, delimiter, marker, maxKeys, withOwner)
+	s.mu.Unlock()
+
+	keyCount := len(contents) + len(prefixes)
+	result := listBucketResult{
+		Name:              r.bucket,
+		Prefix:            prefix,
+		StartAfter:        q.Get("start-after"),
+		ContinuationToken: token,
+		KeyCount:          &keyCount,
+		MaxKeys:           maxKeys,
+		Delimiter:         delimiter,
+		IsTruncated:       next != "",
+		Contents:          contents,
+		CommonPrefixes:    prefixes,
+	}
+	if next != "" {
+		result.NextContinuationToken = base64.RawURLEncoding.EncodeToString([]byte(next))
+	}
+	writeXML(w, http.StatusOK, result)
+}
+
+type versionEntry struct {
+	XMLName      xml.Name
+	Key          string
+	VersionID    string `xml:"VersionId"`
+	IsLatest     bool
+	LastModified string
+	ETag         string `xml:",omitempty"`
+	Size         *int64 `xml:",omitempty"`
+	StorageClass string `xml:",omitempty"`
+}
+
+// listVersionsResult - the response of ListObjectVersions, Version and
+// DeleteMarker elements are interleaved in listing order.
+type listVersionsResult struct {
+	XMLName             xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ ListVersionsResult"`
+	Name                string
+	Prefix              string
+	KeyMarker           string
+	VersionIDMarker     string `xml:"VersionIdMarker"`
+	NextKeyMarker       string `xml:",omitempty"`
+	NextVersionIDMarker string `xml:"NextVersionIdMarker,omitempty"`
+	MaxKeys             int
+	Delimiter           string `xml:",omitempty"`
+	IsTruncated         bool
+	Versions            []versionEntry
+	CommonPrefixes      []commonPrefix
+}
+
+func (s *Server) listObjectVersions(w http.ResponseWriter, r *request) {
+	prefix, delimiter, maxKeys, err := listParams(r)
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	q := r.URL.Query()
+	result := listVersionsResult{
+		Name:            r.bucket,
+		Prefix:          prefix,
+		KeyMarker:       q.Get("key-marker"),
+		VersionIDMarker: q.Get("version-id-marker"),
+		MaxKeys:         maxKeys,
+		Delimiter:       delimiter,
+	}
+
+	b, err := s.lockBucket(r)
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	defer s.mu.Unlock()
+
+	count := 0
+	full := func() bool {
+		if count < maxKeys {
+			count++
+			return false
+		}
+		result.IsTruncated = true
+		return true
+	}
+list:
+	for _, key := range b.sortedKeys() {
+		if !strings.HasPrefix(key, prefix) || key < result.KeyMarker {
+			continue
+		}
+		if cp := commonPrefixOf(key, prefix, delimiter); cp != "" {
+			if cp <= result.KeyMarker || (len(result.CommonPrefixes) > 0 && result.CommonPrefixes[len(result.CommonPrefixes)-1].Prefix == cp) {
+				continue
+			}
+			if full() {
+				break
+			}
+			result.CommonPrefixes = append(result.CommonPrefixes, commonPrefix{Prefix: cp})
+			result.NextKeyMarker, result.NextVersionIDMarker = cp, ""
+			continue
+		}
+
+		versions := b.objects[key].versions
+		skip := key == result.KeyMarker
+		for i := len(versions) - 1; i >= 0; i-- {
+			v := versions[i]
+			if skip {
+				// Versions up to the marker were listed before.
+				if result.VersionIDMarker != "" && v.versionID == result.VersionIDMarker {
+					skip = false
+				}
+				continue
+			}
+			if full() {
+				break list
+			}
+			entry := versionEntry{
+				XMLName:      xml.Name{Local: "Version"},
+				Key:          key,
+				VersionID:    v.versionID,
+				IsLatest:     i == len(versions)-1,
+				LastModified: v.modTime.Format(time.RFC3339Nano),
+			}
+			if v.deleteMarker {
+				entry.XMLName.Local = "DeleteMarker"
+			} else {
+				size := int64(len(v.data))
+				entry.ETag, entry.Size, entry.StorageClass = quoteETag(v.etag), &size, "STANDARD"
+			}
+			result.Versions = append(result.Versions, entry)
+			result.NextKeyMarker, result.NextVersionIDMarker = key, v.versionID
+		}
+	}
+	if !result.IsTruncated {
+		result.NextKeyMarker, result.NextVersionIDMarker = "", ""
+	}
+	writeXML(w, http.StatusOK, result)
+}
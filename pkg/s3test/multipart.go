@@ -0,0 +1,544 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package s3test
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/minio/minio-go/v7"
+)
+
+// Limits of multipart uploads.
+const (
+	maxPartNumber = 10000
+	minPartSize   = 5 << 20
+)
+
+// multipartUpload - an upload in progress, guarded by Server.mu.
+type multipartUpload struct {
+	id        string
+	key       string
+	initiated time.Time
+
+	// Metadata, tags, lock and checksum type of the object to create.
+	object *objectVersion
+
+	parts map[int]*uploadPart
+}
+
+type uploadPart struct {
+	data     []byte
+	etag     string
+	modTime  time.Time
+	checksum string
+}
+
+// lockUpload - returns the upload of r, Server.mu is held on success.
+func (s *Server) lockUpload(r *request) (*bucket, *multipartUpload, error) {
+	b, err := s.lockBucket(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	u, ok := b.uploads[r.URL.Query().Get("uploadId")]
+	if !ok || u.key != r.object {
+		s.mu.Unlock()
+		return nil, nil, errNoSuchUpload
+	}
+	return b, u, nil
+}
+
+type initiateMultipartUploadResult struct {
+	XMLName  xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ InitiateMultipartUploadResult"`
+	Bucket   string
+	Key      string
+	UploadID string `xml:"UploadId"`
+}
+
+func (s *Server) newMultipartUpload(w http.ResponseWriter, r *request) {
+	otags, err := objectTags(r.Header)
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	u := &multipartUpload{
+		id:        uuid.NewString(),
+		key:       r.object,
+		initiated: time.Now().UTC(),
+		object: &objectVersion{
+			header: objectMetadata(r.Header),
+			tags:   otags,
+		},
+		parts: make(map[int]*uploadPart),
+	}
+	if t := checksumAlgorithm(r.Header, minio.ChecksumNone); t.IsSet() {
+		u.object.checksumType, u.object.checksumMode = t, checksumComposite
+		if strings.EqualFold(r.Header.Get("X-Amz-Checksum-Type"), checksumFullObject) {
+			if !t.CanMergeCRC() {
+				s.writeError(w, r.Request, errInvalidRequest)
+				return
+			}
+			u.object.checksumMode = checksumFullObject
+		}
+	} else if r.Header.Get("X-Amz-Checksum-Algorithm") != "" {
+		s.writeError(w, r.Request, errInvalidArgument)
+		return
+	}
+
+	b, err := s.lockBucket(r)
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	defer s.mu.Unlock()
+	u.object.modTime = u.initiated
+	if err = b.applyLock(u.object, r.Header); err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	b.uploads[u.id] = u
+	writeXML(w, http.StatusOK, initiateMultipartUploadResult{
+		Bucket:   r.bucket,
+		Key:      r.object,
+		UploadID: u.id,
+	})
+}
+
+type copyPartResult struct {
+	XMLName      xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ CopyPartResult"`
+	ETag         string
+	LastModified string
+}
+
+func (s *Server) putObjectPart(w http.ResponseWriter, r *request) {
+	number, err := strconv.Atoi(r.URL.Query().Get("partNumber"))
+	if err != nil || number < 1 || number > maxPartNumber {
+		s.writeError(w, r.Request, errInvalidArgument)
+		return
+	}
+	copyPart := r.Header.Get("X-Amz-Copy-Source") != ""
+	p := &payload{}
+	if !copyPart {
+		if p, err = r.body(); err != nil {
+			s.writeError(w, r.Request, err)
+			return
+		}
+	}
+
+	_, u, err := s.lockUpload(r)
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	defer s.mu.Unlock()
+	if copyPart {
+		src, err := s.lookupCopySource(r.Header)
+		if err != nil {
+			s.writeError(w, r.Request, err)
+			return
+		}
+		p.data = src.data
+		if rng := r.Header.Get("X-Amz-Copy-Source-Range"); rng != "" {
+			start, end, err := parseRange(rng, int64(len(src.data)))
+			if err != nil {
+				s.writeError(w, r.Request, err)
+				return
+			}
+			p.data = src.data[start : end+1]
+		}
+	}
+
+	t := u.object.checksumType
+	if p.checksumType.IsSet() && p.checksumType != t {
+		s.writeError(w, r.Request, &apiError{http.StatusBadRequest, "InvalidRequest", "Checksum type mismatch: expected " + t.String() + ", got " + p.checksumType.String() + ".", ""})
+		return
+	}
+	sum := md5.Sum(p.data)
+	part := &uploadPart{
+		data:    p.data,
+		etag:    hex.EncodeToString(sum[:]),
+		modTime: time.Now().UTC(),
+	}
+	if t.IsSet() {
+		part.checksum = t.EncodeToString(p.data)
+	}
+	u.parts[number] = part
+
+	if copyPart {
+		writeXML(w, http.StatusOK, copyPartResult{
+			ETag:         quoteETag(part.etag),
+			LastModified: part.modTime.Format(time.RFC3339Nano),
+		})
+		return
+	}
+	w.Header().Set("ETag", quoteETag(part.etag))
+	if t.IsSet() {
+		w.Header().Set(t.Key(), part.checksum)
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// partChecksums - the checksum elements of parts in requests and
+// responses.
+type partChecksums struct {
+	ChecksumCRC32     string `xml:",omitempty"`
+	ChecksumCRC32C    string `xml:",omitempty"`
+	ChecksumSHA1      string `xml:",omitempty"`
+	ChecksumSHA256    string `xml:",omitempty"`
+	ChecksumCRC64NVME string `xml:",omitempty"`
+}
+
+// field - returns the element of t.
+func (c *partChecksums) field(t minio.ChecksumType) *string {
+	switch t.Base() {
+	case minio.ChecksumCRC32:
+		return &c.ChecksumCRC32
+	case minio.ChecksumCRC32C:
+		return &c.ChecksumCRC32C
+	case minio.ChecksumSHA1:
+		return &c.ChecksumSHA1
+	case minio.ChecksumSHA256:
+		return &c.ChecksumSHA256
+	case minio.ChecksumCRC64NVME:
+		return &c.ChecksumCRC64NVME
+	}
+	return new(string)
+}
+
+type completePart struct {
+	PartNumber int
+	ETag       string
+	partChecksums
+}
+
+type completeMultipartUpload struct {
+	Parts []completePart `xml:"Part"`
+}
+
+type completeMultipartUploadResult struct {
+	XMLName  xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ CompleteMultipartUploadResult"`
+	Location string
+	Bucket   string
+	Key      string
+	ETag     string
+	partChecksums
+	ChecksumType string `xml:",omitempty"`
+}
+
+func (s *Server) completeMultipartUpload(w http.ResponseWriter, r *request) {
+	p, err := r.body()
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	var req completeMultipartUpload
+	if err = xml.Unmarshal(p.data, &req); err != nil || len(req.Parts) == 0 {
+		s.writeError(w, r.Request, errMalformedXML)
+		return
+	}
+
+	b, u, err := s.lockUpload(r)
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	defer s.mu.Unlock()
+
+	v := *u.object
+	t := v.checksumType
+	var data, md5s, checksums []byte
+	for i, cp := range req.Parts {
+		if i > 0 && cp.PartNumber <= req.Parts[i-1].PartNumber {
+			s.writeError(w, r.Request, errInvalidPartOrder)
+			return
+		}
+		part, ok := u.parts[cp.PartNumber]
+		if !ok || strings.Trim(cp.ETag, `"`) != part.etag {
+			s.writeError(w, r.Request, errInvalidPart)
+			return
+		}
+		if sum := *cp.field(t); t.IsSet() && sum != "" && sum != part.checksum {
+			s.writeError(w, r.Request, errInvalidPart)
+			return
+		}
+		if i < len(req.Parts)-1 && len(part.data) < minPartSize {
+			s.writeError(w, r.Request, errEntityTooSmall)
+			return
+		}
+		data = append(data, part.data...)
+		sum, _ := hex.DecodeString(part.etag)
+		md5s = append(md5s, sum...)
+		if t.IsSet() {
+			raw, _ := base64.StdEncoding.DecodeString(part.checksum)
+			checksums = append(checksums, raw...)
+		}
+		v.partSizes = append(v.partSizes, int64(len(part.data)))
+	}
+	sum := md5.Sum(md5s)
+	v.data = data
+	v.etag = hex.EncodeToString(sum[:]) + "-" + strconv.Itoa(len(req.Parts))
+	v.modTime = time.Now().UTC()
+
+	// The checksum sent with the request excludes the part count of
+	// composite checksums.
+	if t.IsSet() {
+		if v.checksumMode == checksumFullObject {
+			v.checksum = t.EncodeToString(data)
+		} else {
+			v.checksum = t.EncodeToString(checksums)
+		}
+		if want := r.Header.Get(t.Key()); want != "" && want != v.checksum {
+			s.writeError(w, r.Request, errBadDigest)
+			return
+		}
+		if v.checksumMode == checksumComposite {
+			v.checksum += "-" + strconv.Itoa(len(req.Parts))
+		}
+	}
+
+	b.addVersion(r.object, &v)
+	delete(b.uploads, u.id)
+
+	result := completeMultipartUploadResult{
+		Location: s.URL() + "/" + r.bucket + "/" + r.object,
+		Bucket:   r.bucket,
+		Key:      r.object,
+		ETag:     quoteETag(v.etag),
+	}
+	if t.IsSet() {
+		*result.field(t) = v.checksum
+		result.ChecksumType = v.checksumMode
+	}
+	b.setVersionHeader(w.Header(), &v)
+	writeXML(w, http.StatusOK, result)
+}
+
+func (s *Server) abortMultipartUpload(w http.ResponseWriter, r *request) {
+	b, u, err := s.lockUpload(r)
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	delete(b.uploads, u.id)
+	s.mu.Unlock()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type partEntry struct {
+	PartNumber   int
+	LastModified string
+	ETag         string
+	Size         int64
+	partChecksums
+}
+
+type listPartsResult struct {
+	XMLName              xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ ListPartsResult"`
+	Bucket               string
+	Key                  string
+	UploadID             string `xml:"UploadId"`
+	Initiator            owner
+	Owner                owner
+	StorageClass         string
+	PartNumberMarker     int
+	NextPartNumberMarker int
+	MaxParts             int
+	ChecksumAlgorithm    string `xml:",omitempty"`
+	ChecksumType         string `xml:",omitempty"`
+	IsTruncated          bool
+	Parts                []partEntry `xml:"Part"`
+}
+
+func (s *Server) listObjectParts(w http.ResponseWriter, r *request) {
+	q := r.URL.Query()
+	maxParts := maxListKeys
+	var marker int
+	var err error
+	if v := q.Get("max-parts"); v != "" {
+		if maxParts, err = strconv.Atoi(v); err != nil || maxParts < 0 {
+			s.writeError(w, r.Request, errInvalidArgument)
+			return
+		}
+		maxParts = min(maxParts, maxListKeys)
+	}
+	if v := q.Get("part-number-marker"); v != "" {
+		if marker, err = strconv.Atoi(v); err != nil || marker < 0 {
+			s.writeError(w, r.Request, errInvalidArgument)
+			return
+		}
+	}
+
+	_, u, err := s.lockUpload(r)
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	defer s.mu.Unlock()
+
+	result := listPartsResult{
+		Bucket:           r.bucket,
+		Key:              r.object,
+		UploadID:         u.id,
+		Initiator:        owner{ID: s.accessKey, DisplayName: s.accessKey},
+		Owner:            owner{ID: s.accessKey, DisplayName: s.accessKey},
+		StorageClass:     "STANDARD",
+		PartNumberMarker: marker,
+		MaxParts:         maxParts,
+	}
+	t := u.object.checksumType
+	if t.IsSet() {
+		result.ChecksumAlgorithm, result.ChecksumType = t.String(), u.object.checksumMode
+	}
+	numbers := make([]int, 0, len(u.parts))
+	for number := range u.parts {
+		if number > marker {
+			numbers = append(numbers, number)
+		}
+	}
+	slices.Sort(numbers)
+	for _, number := range numbers {
+		if len(result.Parts) == maxParts {
+			result.IsTruncated = true
+			break
+		}
+		part := u.parts[number]
+		entry := partEntry{
+			PartNumber:   number,
+			LastModified: part.modTime.Format(time.RFC3339Nano),
+			ETag:         quoteETag(part.etag),
+			Size:         int64(len(part.data)),
+		}
+		if t.IsSet() {
+			*entry.field(t) = part.checksum
+		}
+		result.Parts = append(result.Parts, entry)
+		result.NextPartNumberMarker = number
+	}
+	writeXML(w, http.StatusOK, result)
+}
+
+type uploadEntry struct {
+	Key          string
+	UploadID     string `xml:"UploadId"`
+	Initiator    owner
+	Owner        owner
+	StorageClass string
+	Initiated    string
+}
+
+type listMultipartUploadsResult struct {
+	XMLName            xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ ListMultipartUploadsResult"`
+	Bucket             string
+	KeyMarker          string
+	UploadIDMarker     string `xml:"UploadIdMarker"`
+	NextKeyMarker      string
+	NextUploadIDMarker string `xml:"NextUploadIdMarker"`
+	MaxUploads         int
+	Prefix             string
+	Delimiter          string `xml:",omitempty"`
+	IsTruncated        bool
+	Uploads            []uploadEntry `xml:"Upload"`
+	CommonPrefixes     []commonPrefix
+}
+
+func (s *Server) listMultipartUploads(w http.ResponseWriter, r *request) {
+	q := r.URL.Query()
+	maxUploads := maxListKeys
+	if v := q.Get("max-uploads"); v != "" {
+		var err error
+		if maxUploads, err = strconv.Atoi(v); err != nil || maxUploads < 0 {
+			s.writeError(w, r.Request, errInvalidArgument)
+			return
+		}
+		maxUploads = min(maxUploads, maxListKeys)
+	}
+	result := listMultipartUploadsResult{
+		Bucket:         r.bucket,
+		KeyMarker:      q.Get("key-marker"),
+		UploadIDMarker: q.Get("upload-id-marker"),
+		MaxUploads:     maxUploads,
+		Prefix:         q.Get("prefix"),
+		Delimiter:      q.Get("delimiter"),
+	}
+
+	b, err := s.lockBucket(r)
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	defer s.mu.Unlock()
+
+	// Uploads are listed by key, then by initiation time.
+	uploads := make([]*multipartUpload, 0, len(b.uploads))
+	for _, u := range b.uploads {
+		if strings.HasPrefix(u.key, result.Prefix) && u.key >= result.KeyMarker {
+			uploads = append(uploads, u)
+		}
+	}
+	slices.SortFunc(uploads, func(a, b *multipartUpload) int {
+		if c := strings.Compare(a.key, b.key); c != 0 {
+			return c
+		}
+		return a.initiated.Compare(b.initiated)
+	})
+	passed := false
+	for _, u := range uploads {
+		if u.key == result.KeyMarker && (result.UploadIDMarker == "" || !passed) {
+			// Uploads up to the marker were listed before.
+			passed = passed || u.id == result.UploadIDMarker
+			continue
+		}
+		if cp := commonPrefixOf(u.key, result.Prefix, result.Delimiter); cp != "" {
+			if cp <= result.KeyMarker || (len(result.CommonPrefixes) > 0 && result.CommonPrefixes[len(result.CommonPrefixes)-1].Prefix == cp) {
+				continue
+			}
+			if len(result.Uploads)+len(result.CommonPrefixes) == maxUploads {
+				result.IsTruncated = true
+				break
+			}
+			result.CommonPrefixes = append(result.CommonPrefixes, commonPrefix{Prefix: cp})
+			result.NextKeyMarker, result.NextUploadIDMarker = cp, ""
+			continue
+		}
+		if len(result.Uploads)+len(result.CommonPrefixes) == maxUploads {
+			result.IsTruncated = true
+			break
+		}
+		result.Uploads = append(result.Uploads, uploadEntry{
+			Key:          u.key,
+			UploadID:     u.id,
+			Initiator:    owner{ID: s.accessKey, DisplayName: s.accessKey},
+			Owner:        owner{ID: s.accessKey, DisplayName: s.accessKey},
+			StorageClass: "STANDARD",
+			Initiated:    u.initiated.Format(time.RFC3339Nano),
+		})
+		result.NextKeyMarker, result.NextUploadIDMarker = u.key, u.id
+	}
+	if !result.IsTruncated {
+		result.NextKeyMarker, result.NextUploadIDMarker = "", ""
+	}
+	writeXML(w, http.StatusOK, result)
+}
@@ -0,0 +1,932 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package s3test
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/tags"
+)
+
+// Checksum modes of an object.
+const (
+	checksumFullObject = "FULL_OBJECT"
+	checksumComposite  = "COMPOSITE"
+)
+
+// Object lock modes.
+const (
+	lockGovernance = "GOVERNANCE"
+	lockCompliance = "COMPLIANCE"
+)
+
+// Stored headers of an object besides x-amz-meta-*.
+var storedHeaders = []string{
+	"Cache-Control",
+	"Content-Disposition",
+	"Content-Encoding",
+	"Content-Language",
+	"Content-Type",
+	"Expires",
+	"X-Amz-Storage-Class",
+	"X-Amz-Website-Redirect-Location",
+}
+
+// objectVersion - a version of an object, data is never modified.
+type objectVersion struct {
+	versionID    string
+	deleteMarker bool
+
+	data    []byte
+	etag    string
+	modTime time.Time
+	header  http.Header
+	tags    *tags.Tags
+
+	// Sizes of the parts of multipart uploads.
+	partSizes []int64
+
+	checksumType minio.ChecksumType
+	checksum     string
+	checksumMode string
+
+	lockMode    string
+	retainUntil time.Time
+	legalHold   bool
+}
+
+// locked - returns true if the version cannot be deleted, governance
+// retention is bypassed if bypass is set.
+func (v *objectVersion) locked(bypass bool) bool {
+	if v.legalHold {
+		return true
+	}
+	if v.lockMode == "" || time.Now().After(v.retainUntil) {
+		return false
+	}
+	return v.lockMode == lockCompliance || !bypass
+}
+
+// quoteETag - returns etag in the quoted form of ETag headers.
+func quoteETag(etag string) string {
+	return `"` + etag + `"`
+}
+
+// matchETag - returns true if the value of an If-Match or If-None-Match
+// header matches etag.
+func matchETag(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.Trim(candidate, `"`) == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// objectMetadata - returns the headers of h stored with an object.
+func objectMetadata(h http.Header) http.Header {
+	meta := make(http.Header)
+	for key, values := range h {
+		if strings.HasPrefix(key, "X-Amz-Meta-") {
+			meta[key] = values
+		}
+	}
+	for _, key := range storedHeaders {
+		if value := h.Get(key); value != "" {
+			meta.Set(key, value)
+		}
+	}
+	// aws-chunked is the encoding of the request only.
+	if encoding := meta.Get("Content-Encoding"); encoding != "" {
+		var kept []string
+		for _, e := range strings.Split(encoding, ",") {
+			if e = strings.TrimSpace(e); e != "aws-chunked" {
+				kept = append(kept, e)
+			}
+		}
+		if len(kept) == 0 {
+			meta.Del("Content-Encoding")
+		} else {
+			meta.Set("Content-Encoding", strings.Join(kept, ","))
+		}
+	}
+	return meta
+}
+
+// objectTags - returns the tags of the x-amz-tagging header of h.
+func objectTags(h http.Header) (*tags.Tags, error) {
+	value := h.Get("X-Amz-Tagging")
+	if value == "" {
+		return nil, nil
+	}
+	t, err := tags.ParseObjectTags(value)
+	if err != nil {
+		return nil, &apiError{http.StatusBadRequest, "InvalidTag", err.Error(), ""}
+	}
+	return t, nil
+}
+
+// objectLockRule - the default retention of an object lock
+// configuration.
+type objectLockRule struct {
+	Rule struct {
+		DefaultRetention struct {
+			Mode  string
+			Days  int
+			Years int
+		}
+	}
+}
+
+// applyLock - sets the object lock of v from the headers of h or the
+// default retention of b.
+func (b *bucket) applyLock(v *objectVersion, h http.Header) error {
+	mode := h.Get("X-Amz-Object-Lock-Mode")
+	until := h.Get("X-Amz-Object-Lock-Retain-Until-Date")
+	hold := h.Get("X-Amz-Object-Lock-Legal-Hold")
+	if mode == "" && until == "" && hold == "" {
+		if b.objectLockConfig == nil {
+			return nil
+		}
+		var config objectLockRule
+		if err := xml.Unmarshal(b.objectLockConfig, &config); err != nil {
+			return err
+		}
+		retention := config.Rule.DefaultRetention
+		if retention.Mode != "" {
+			v.lockMode = retention.Mode
+			v.retainUntil = v.modTime.AddDate(retention.Years, 0, retention.Days)
+		}
+		return nil
+	}
+	if !b.objectLock {
+		return errInvalidRequest
+	}
+	if mode != "" || until != "" {
+		if mode != lockGovernance && mode != lockCompliance {
+			return errInvalidArgument
+		}
+		t, err := time.Parse(time.RFC3339, until)
+		if err != nil {
+			return errInvalidArgument
+		}
+		v.lockMode, v.retainUntil = mode, t
+	}
+	switch hold {
+	case "", "OFF":
+	case "ON":
+		v.legalHold = true
+	default:
+		return errInvalidArgument
+	}
+	return nil
+}
+
+// addVersion - adds v as the latest version of key, assigning its
+// version ID. A previous null version is replaced.
+func (b *bucket) addVersion(key string, v *objectVersion) {
+	v.versionID = "null"
+	if b.versioning == versioningEnabled {
+		v.versionID = uuid.NewString()
+	}
+	o, ok := b.objects[key]
+	if !ok {
+		o = &object{}
+		b.objects[key] = o
+	}
+	if v.versionID == "null" {
+		for i, old := range o.versions {
+			if old.versionID == "null" {
+				o.versions = append(o.versions[:i], o.versions[i+1:]...)
+				break
+			}
+		}
+	}
+	o.versions = append(o.versions, v)
+}
+
+// setVersionHeader - sets the version ID of v on h for versioned
+// buckets.
+func (b *bucket) setVersionHeader(h http.Header, v *objectVersion) {
+	if b.versioning != "" {
+		h.Set("X-Amz-Version-Id", v.versionID)
+	}
+}
+
+// checkPutPreconditions - verifies the If-Match and If-None-Match
+// headers of a write of key.
+func (b *bucket) checkPutPreconditions(h http.Header, key string) error {
+	var etag string
+	if o, ok := b.objects[key]; ok && !o.latest().deleteMarker {
+		etag = o.latest().etag
+	}
+	if match := h.Get("If-Match"); match != "" && (etag == "" || !matchETag(match, etag)) {
+		return errPreconditionFailed
+	}
+	if match := h.Get("If-None-Match"); match != "" && etag != "" && matchETag(match, etag) {
+		return errPreconditionFailed
+	}
+	return nil
+}
+
+func (s *Server) putObject(w http.ResponseWriter, r *request) {
+	p, err := r.body()
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	otags, err := objectTags(r.Header)
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	sum := md5.Sum(p.data)
+	v := &objectVersion{
+		data:         p.data,
+		etag:         hex.EncodeToString(sum[:]),
+		modTime:      time.Now().UTC(),
+		header:       objectMetadata(r.Header),
+		tags:         otags,
+		checksumType: p.checksumType,
+		checksum:     p.checksum,
+	}
+	if p.checksum != "" {
+		v.checksumMode = checksumFullObject
+	}
+
+	b, err := s.lockBucket(r)
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	defer s.mu.Unlock()
+	if err = b.checkPutPreconditions(r.Header, r.object); err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	if err = b.applyLock(v, r.Header); err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	b.addVersion(r.object, v)
+
+	w.Header().Set("ETag", quoteETag(v.etag))
+	b.setVersionHeader(w.Header(), v)
+	if v.checksum != "" {
+		w.Header().Set(v.checksumType.Key(), v.checksum)
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// lookupVersion - returns the version of key selected by the versionId
+// query of r, Server.mu must be held.
+func (b *bucket) lookupVersion(r *request, key string) (*objectVersion, error) {
+	versionID := r.URL.Query().Get("versionId")
+	o, ok := b.objects[key]
+	if !ok {
+		if versionID != "" {
+			return nil, errNoSuchVersion
+		}
+		return nil, errNoSuchKey
+	}
+	v := o.version(versionID)
+	if v == nil {
+		return nil, errNoSuchVersion
+	}
+	return v, nil
+}
+
+// parseRange - returns the first and last byte of a Range header for an
+// object of size.
+func parseRange(header string, size int64) (start, end int64, err error) {
+	spec, ok := strings.CutPrefix(header, "bytes=")
+	if !ok || strings.Contains(spec, ",") {
+		return 0, 0, errInvalidRange
+	}
+	first, last, _ := strings.Cut(spec, "-")
+	switch {
+	case first == "":
+		n, err := strconv.ParseInt(last, 10, 64)
+		if err != nil || n <= 0 {
+			return 0, 0, errInvalidRange
+		}
+		return max(size-n, 0), size - 1, nil
+	default:
+		start, err = strconv.ParseInt(first, 10, 64)
+		if err != nil || start >= size {
+			return 0, 0, errInvalidRange
+		}
+		end = size - 1
+		if last != "" {
+			if end, err = strconv.ParseInt(last, 10, 64); err != nil || end < start {
+				return 0, 0, errInvalidRange
+			}
+			end = min(end, size-1)
+		}
+		return start, end, nil
+	}
+}
+
+// checkGetPreconditions - verifies the conditional headers of a read
+// of v.
+func checkGetPreconditions(h http.Header, v *objectVersion) error {
+	modTime := v.modTime.Truncate(time.Second)
+	if match := h.Get("If-Match"); match != "" {
+		if !matchETag(match, v.etag) {
+			return errPreconditionFailed
+		}
+	} else if since, err := http.ParseTime(h.Get("If-Unmodified-Since")); err == nil && modTime.After(since) {
+		return errPreconditionFailed
+	}
+	if match := h.Get("If-None-Match"); match != "" {
+		if matchETag(match, v.etag) {
+			return errNotModified
+		}
+	} else if since, err := http.ParseTime(h.Get("If-Modified-Since")); err == nil && !modTime.After(since) {
+		return errNotModified
+	}
+	return nil
+}
+
+// setObjectHeaders - sets the headers describing v on h.
+func (b *bucket) setObjectHeaders(h http.Header, v *objectVersion, withChecksum bool) {
+	for key, values := range v.header {
+		h[key] = values
+	}
+	if h.Get("Content-Type") == "" {
+		h.Set("Content-Type", "binary/octet-stream")
+	}
+	h.Set("ETag", quoteETag(v.etag))
+	h.Set("Last-Modified", v.modTime.Format(http.TimeFormat))
+	h.Set("Accept-Ranges", "bytes")
+	b.setVersionHeader(h, v)
+	if v.tags != nil && v.tags.Count() > 0 {
+		h.Set("X-Amz-Tagging-Count", strconv.Itoa(v.tags.Count()))
+	}
+	if v.lockMode != "" {
+		h.Set("X-Amz-Object-Lock-Mode", v.lockMode)
+		h.Set("X-Amz-Object-Lock-Retain-Until-Date", v.retainUntil.Format(time.RFC3339))
+	}
+	if b.objectLock {
+		h.Set("X-Amz-Object-Lock-Legal-Hold", legalHoldStatus(v.legalHold))
+	}
+	if len(v.partSizes) > 0 {
+		h.Set("X-Amz-Mp-Parts-Count", strconv.Itoa(len(v.partSizes)))
+	}
+	if withChecksum && v.checksum != "" {
+		h.Set(v.checksumType.Key(), v.checksum)
+		h.Set("X-Amz-Checksum-Type", v.checksumMode)
+	}
+}
+
+func legalHoldStatus(on bool) string {
+	if on {
+		return "ON"
+	}
+	return "OFF"
+}
+
+func (s *Server) getObject(w http.ResponseWriter, r *request, head bool) {
+	b, err := s.lockBucket(r)
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	v, err := b.lookupVersion(r, r.object)
+	if err == nil && v.deleteMarker {
+		w.Header().Set("X-Amz-Delete-Marker", "true")
+		b.setVersionHeader(w.Header(), v)
+		err = errNoSuchKey
+		if r.URL.Query().Get("versionId") != "" {
+			w.Header().Set("Last-Modified", v.modTime.Format(http.TimeFormat))
+			err = errMethodNotAllowed
+		}
+	}
+	if err != nil {
+		s.mu.Unlock()
+		s.writeError(w, r.Request, err)
+		return
+	}
+
+	size := int64(len(v.data))
+	start, end := int64(0), size-1
+	partial := false
+	if part := r.URL.Query().Get("partNumber"); part != "" {
+		n, err := strconv.Atoi(part)
+		sizes := v.partSizes
+		if sizes == nil {
+			sizes = []int64{size}
+		}
+		if err != nil || n < 1 || n > len(sizes) {
+			s.mu.Unlock()
+			s.writeError(w, r.Request, &apiError{http.StatusRequestedRangeNotSatisfiable, "InvalidPartNumber", "The requested partnumber is not satisfiable.", ""})
+			return
+		}
+		for _, partSize := range sizes[:n-1] {
+			start += partSize
+		}
+		end = start + sizes[n-1] - 1
+		partial = v.partSizes != nil
+	} else if rng := r.Header.Get("Range"); rng != "" && size > 0 {
+		if start, end, err = parseRange(rng, size); err != nil {
+			s.mu.Unlock()
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes */%d", size))
+			s.writeError(w, r.Request, err)
+			return
+		}
+		partial = true
+	}
+	b.setObjectHeaders(w.Header(), v, !partial && strings.EqualFold(r.Header.Get("X-Amz-Checksum-Mode"), "ENABLED"))
+	s.mu.Unlock()
+
+	if err = checkGetPreconditions(r.Header, v); err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+
+	status := http.StatusOK
+	if partial {
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end, size))
+		status = http.StatusPartialContent
+	}
+	w.Header().Set("Content-Length", strconv.FormatInt(end-start+1, 10))
+	w.WriteHeader(status)
+	if !head {
+		w.Write(v.data[start : end+1])
+	}
+}
+
+// deleteResult - the outcome of deleting an object or a version.
+type deleteResult struct {
+	versionID             string
+	deleteMarker          bool
+	deleteMarkerVersionID string
+}
+
+// deleteVersion - deletes a version of key or, if versionID is empty,
+// the object itself, adding a delete marker to versioned buckets.
+func (b *bucket) deleteVersion(key, versionID string, bypass bool) (deleteResult, error) {
+	o, ok := b.objects[key]
+	if versionID == "" {
+		if b.versioning == "" {
+			delete(b.objects, key)
+			return deleteResult{}, nil
+		}
+		marker := &objectVersion{deleteMarker: true, modTime: time.Now().UTC()}
+		b.addVersion(key, marker)
+		return deleteResult{deleteMarker: true, deleteMarkerVersionID: marker.versionID}, nil
+	}
+
+	result := deleteResult{versionID: versionID}
+	if !ok {
+		return result, nil
+	}
+	for i, v := range o.versions {
+		if v.versionID != versionID {
+			continue
+		}
+		if v.locked(bypass) {
+			return result, errObjectLocked
+		}
+		o.versions = append(o.versions[:i], o.versions[i+1:]...)
+		if len(o.versions) == 0 {
+			delete(b.objects, key)
+		}
+		if v.deleteMarker {
+			result.deleteMarker, result.deleteMarkerVersionID = true, versionID
+		}
+		break
+	}
+	return result, nil
+}
+
+func (s *Server) deleteObject(w http.ResponseWriter, r *request) {
+	b, err := s.lockBucket(r)
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	defer s.mu.Unlock()
+	bypass := strings.EqualFold(r.Header.Get("X-Amz-Bypass-Governance-Retention"), "true")
+	result, err := b.deleteVersion(r.object, r.URL.Query().Get("versionId"), bypass)
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	if result.deleteMarker {
+		w.Header().Set("X-Amz-Delete-Marker", "true")
+		w.Header().Set("X-Amz-Version-Id", result.deleteMarkerVersionID)
+	} else if result.versionID != "" {
+		w.Header().Set("X-Amz-Version-Id", result.versionID)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type deleteObjectsRequest struct {
+	Quiet   bool
+	Objects []struct {
+		Key       string
+		VersionID string `xml:"VersionId"`
+	} `xml:"Object"`
+}
+
+type deletedObject struct {
+	Key                   string
+	VersionID             string `xml:"VersionId,omitempty"`
+	DeleteMarker          bool   `xml:",omitempty"`
+	DeleteMarkerVersionID string `xml:"DeleteMarkerVersionId,omitempty"`
+}
+
+type deleteError struct {
+	Key       string
+	VersionID string `xml:"VersionId,omitempty"`
+	Code      string
+	Message   string
+}
+
+type deleteObjectsResult struct {
+	XMLName xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ DeleteResult"`
+	Deleted []deletedObject
+	Errors  []deleteError `xml:"Error"`
+}
+
+func (s *Server) deleteObjects(w http.ResponseWriter, r *request) {
+	p, err := r.body()
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	var req deleteObjectsRequest
+	if err = xml.Unmarshal(p.data, &req); err != nil || len(req.Objects) > maxListKeys {
+		s.writeError(w, r.Request, errMalformedXML)
+		return
+	}
+
+	b, err := s.lockBucket(r)
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	bypass := strings.EqualFold(r.Header.Get("X-Amz-Bypass-Governance-Retention"), "true")
+	var result deleteObjectsResult
+	for _, obj := range req.Objects {
+		deleted, err := b.deleteVersion(obj.Key, obj.VersionID, bypass)
+		if err != nil {
+			e := err.(*apiError)
+			result.Errors = append(result.Errors, deleteError{Key: obj.Key, VersionID: obj.VersionID, Code: e.code, Message: e.message})
+			continue
+		}
+		if !req.Quiet {
+			result.Deleted = append(result.Deleted, deletedObject{
+				Key:                   obj.Key,
+				VersionID:             obj.VersionID,
+				DeleteMarker:          deleted.deleteMarker,
+				DeleteMarkerVersionID: deleted.deleteMarkerVersionID,
+			})
+		}
+	}
+	s.mu.Unlock()
+	writeXML(w, http.StatusOK, result)
+}
+
+// copySource - returns the bucket, key and version ID of the
+// x-amz-copy-source header of h.
+func copySource(h http.Header) (bucketName, key, versionID string, err error) {
+	source, err := url.PathUnescape(h.Get("X-Amz-Copy-Source"))
+	if err != nil {
+		return "", "", "", errInvalidArgument
+	}
+	source, query, _ := strings.Cut(strings.TrimPrefix(source, "/"), "?")
+	if versionID, _ = strings.CutPrefix(query, "versionId="); query != "" && versionID == "" {
+		return "", "", "", errInvalidArgument
+	}
+	bucketName, key, _ = strings.Cut(source, "/")
+	if bucketName == "" || key == "" {
+		return "", "", "", errInvalidArgument
+	}
+	return bucketName, key, versionID, nil
+}
+
+// lookupCopySource - returns the source version of a copy request,
+// Server.mu must be held.
+func (s *Server) lookupCopySource(h http.Header) (*objectVersion, error) {
+	bucketName, key, versionID, err := copySource(h)
+	if err != nil {
+		return nil, err
+	}
+	b, ok := s.buckets[bucketName]
+	if !ok {
+		return nil, errNoSuchBucket
+	}
+	o, ok := b.objects[key]
+	if !ok {
+		return nil, errNoSuchKey
+	}
+	v := o.version(versionID)
+	if v == nil {
+		return nil, errNoSuchVersion
+	}
+	if v.deleteMarker {
+		return nil, errNoSuchKey
+	}
+
+	// The copy-source conditional headers only fail with 412.
+	conditions := make(http.Header)
+	for _, key := range []string{"If-Match", "If-None-Match", "If-Modified-Since", "If-Unmodified-Since"} {
+		if value := h.Get("X-Amz-Copy-Source-" + key); value != "" {
+			conditions.Set(key, value)
+		}
+	}
+	if err = checkGetPreconditions(conditions, v); err != nil {
+		return nil, errPreconditionFailed
+	}
+	return v, nil
+}
+
+type copyObjectResult struct {
+	XMLName      xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ CopyObjectResult"`
+	ETag         string
+	LastModified string
+}
+
+func (s *Server) copyObject(w http.ResponseWriter, r *request) {
+	replaceMetadata := r.Header.Get("X-Amz-Metadata-Directive") == "REPLACE"
+	replaceTags := r.Header.Get("X-Amz-Tagging-Directive") == "REPLACE"
+	otags, err := objectTags(r.Header)
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+
+	b, err := s.lockBucket(r)
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	defer s.mu.Unlock()
+	src, err := s.lookupCopySource(r.Header)
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	srcBucket, srcKey, _, _ := copySource(r.Header)
+	if srcBucket == r.bucket && srcKey == r.object && !replaceMetadata {
+		s.writeError(w, r.Request, &apiError{http.StatusBadRequest, "InvalidRequest", "This copy request is illegal because it is trying to copy an object to itself without changing the object's metadata, storage class, website redirect location or encryption attributes.", ""})
+		return
+	}
+
+	v := &objectVersion{
+		data:      src.data,
+		etag:      src.etag,
+		modTime:   time.Now().UTC(),
+		header:    src.header,
+		tags:      src.tags,
+		partSizes: src.partSizes,
+	}
+	if replaceMetadata {
+		v.header = objectMetadata(r.Header)
+	}
+	if replaceTags {
+		v.tags = otags
+	}
+	// Copies are single part objects with a full object checksum.
+	if len(src.partSizes) > 0 {
+		sum := md5.Sum(src.data)
+		v.etag, v.partSizes = hex.EncodeToString(sum[:]), nil
+	}
+	if t := checksumAlgorithm(r.Header, src.checksumType); t.IsSet() {
+		v.checksumType, v.checksum, v.checksumMode = t, t.EncodeToString(v.data), checksumFullObject
+	}
+	if err = b.applyLock(v, r.Header); err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	b.addVersion(r.object, v)
+
+	b.setVersionHeader(w.Header(), v)
+	if src.versionID != "" && src.versionID != "null" {
+		w.Header().Set("X-Amz-Copy-Source-Version-Id", src.versionID)
+	}
+	writeXML(w, http.StatusOK, copyObjectResult{
+		ETag:         quoteETag(v.etag),
+		LastModified: v.modTime.Format(time.RFC3339Nano),
+	})
+}
+
+// checksumAlgorithm - returns the checksum type of the
+// x-amz-checksum-algorithm header of h, def if not set.
+func checksumAlgorithm(h http.Header, def minio.ChecksumType) minio.ChecksumType {
+	name := h.Get("X-Amz-Checksum-Algorithm")
+	if name == "" {
+		return def
+	}
+	for _, t := range checksumTypes {
+		if strings.EqualFold(t.String(), name) {
+			return t
+		}
+	}
+	return minio.ChecksumNone
+}
+
+// lockVersion - returns the version of r that is not a delete marker,
+// Server.mu is held on success.
+func (s *Server) lockVersion(r *request) (*bucket, *objectVersion, error) {
+	b, err := s.lockBucket(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	v, err := b.lookupVersion(r, r.object)
+	if err == nil && v.deleteMarker {
+		err = errMethodNotAllowed
+	}
+	if err != nil {
+		s.mu.Unlock()
+		return nil, nil, err
+	}
+	return b, v, nil
+}
+
+func (s *Server) putObjectTagging(w http.ResponseWriter, r *request) {
+	p, err := r.body()
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	t, err := tags.ParseObjectXML(bytes.NewReader(p.data))
+	if err != nil {
+		s.writeError(w, r.Request, &apiError{http.StatusBadRequest, "InvalidTag", err.Error(), ""})
+		return
+	}
+	b, v, err := s.lockVersion(r)
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	v.tags = t
+	b.setVersionHeader(w.Header(), v)
+	s.mu.Unlock()
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) getObjectTagging(w http.ResponseWriter, r *request) {
+	b, v, err := s.lockVersion(r)
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	t := v.tags
+	b.setVersionHeader(w.Header(), v)
+	s.mu.Unlock()
+	if t == nil {
+		t, _ = tags.NewTags(nil, true)
+	}
+	writeXML(w, http.StatusOK, t)
+}
+
+func (s *Server) deleteObjectTagging(w http.ResponseWriter, r *request) {
+	b, v, err := s.lockVersion(r)
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	v.tags = nil
+	b.setVersionHeader(w.Header(), v)
+	s.mu.Unlock()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type retention struct {
+	XMLName         xml.Name `xml:"Retention"`
+	Mode            string
+	RetainUntilDate string
+}
+
+func (s *Server) putObjectRetention(w http.ResponseWriter, r *request) {
+	p, err := r.body()
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	var config retention
+	if err = xml.Unmarshal(p.data, &config); err != nil {
+		s.writeError(w, r.Request, errMalformedXML)
+		return
+	}
+	until, err := time.Parse(time.RFC3339, config.RetainUntilDate)
+	if err != nil || (config.Mode != lockGovernance && config.Mode != lockCompliance) {
+		s.writeError(w, r.Request, errMalformedXML)
+		return
+	}
+
+	b, v, err := s.lockVersion(r)
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	defer s.mu.Unlock()
+	if !b.objectLock {
+		s.writeError(w, r.Request, errInvalidRequest)
+		return
+	}
+	// Active retention can only be extended, unless governance mode
+	// is bypassed.
+	bypass := strings.EqualFold(r.Header.Get("X-Amz-Bypass-Governance-Retention"), "true")
+	if v.lockMode != "" && time.Now().Before(v.retainUntil) &&
+		(config.Mode != v.lockMode || until.Before(v.retainUntil)) &&
+		(v.lockMode == lockCompliance || !bypass) {
+		s.writeError(w, r.Request, errObjectLocked)
+		return
+	}
+	v.lockMode, v.retainUntil = config.Mode, until
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) getObjectRetention(w http.ResponseWriter, r *request) {
+	b, v, err := s.lockVersion(r)
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	enabled, mode, until := b.objectLock, v.lockMode, v.retainUntil
+	s.mu.Unlock()
+	switch {
+	case !enabled:
+		s.writeError(w, r.Request, errInvalidRequest)
+	case mode == "":
+		s.writeError(w, r.Request, errNoSuchObjectLock)
+	default:
+		writeXML(w, http.StatusOK, retention{Mode: mode, RetainUntilDate: until.Format(time.RFC3339)})
+	}
+}
+
+type legalHold struct {
+	XMLName xml.Name `xml:"LegalHold"`
+	Status  string
+}
+
+func (s *Server) putObjectLegalHold(w http.ResponseWriter, r *request) {
+	p, err := r.body()
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	var config legalHold
+	if err = xml.Unmarshal(p.data, &config); err != nil || (config.Status != "ON" && config.Status != "OFF") {
+		s.writeError(w, r.Request, errMalformedXML)
+		return
+	}
+	b, v, err := s.lockVersion(r)
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	defer s.mu.Unlock()
+	if !b.objectLock {
+		s.writeError(w, r.Request, errInvalidRequest)
+		return
+	}
+	v.legalHold = config.Status == "ON"
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) getObjectLegalHold(w http.ResponseWriter, r *request) {
+	b, v, err := s.lockVersion(r)
+	if err != nil {
+		s.writeError(w, r.Request, err)
+		return
+	}
+	enabled, on := b.objectLock, v.legalHold
+	s.mu.Unlock()
+	if !enabled {
+		s.writeError(w, r.Request, errInvalidRequest)
+		return
+	}
+	writeXML(w, http.StatusOK, legalHold{Status: legalHoldStatus(on)})
+}
@@ -0,0 +1,284 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package s3test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"sync"
+)
+
+// ErrNoInteraction is returned by a Replayer for requests that match no
+// remaining recorded interaction.
+var ErrNoInteraction = errors.New("s3test: no recorded interaction matches the request")
+
+// Headers and query parameters that differ between runs of the same
+// requests or carry credentials, and are not recorded nor matched. SSE-C
+// requests are still matched by the MD5 of their key.
+var (
+	ignoredHeaders = []string{
+		"Authorization",
+		"Date",
+		"User-Agent",
+		"X-Amz-Content-Sha256",
+		"X-Amz-Date",
+		"X-Amz-Security-Token",
+		"X-Amz-S3session-Token",
+		"X-Amz-Server-Side-Encryption-Customer-Key",
+		"X-Amz-Copy-Source-Server-Side-Encryption-Customer-Key",
+	}
+	ignoredQuery = []string{
+		"X-Amz-Credential",
+		"X-Amz-Date",
+		"X-Amz-Security-Token",
+		"X-Amz-S3session-Token",
+		"X-Amz-Signature",
+	}
+)
+
+// Interaction is a request and its response, as recorded on a line of
+// an interactions file.
+type Interaction struct {
+	Request struct {
+		Method string      `json:"method"`
+		Path   string      `json:"path"`
+		Query  string      `json:"query,omitempty"`
+		Header http.Header `json:"header,omitempty"`
+	} `json:"request"`
+	Response struct {
+		StatusCode int         `json:"statusCode,omitempty"`
+		Header     http.Header `json:"header,omitempty"`
+		Body       []byte      `json:"body,omitempty"`
+	} `json:"response"`
+	// Error of the transport, instead of a response.
+	Error string `json:"error,omitempty"`
+}
+
+// setRequest - sets the normalized method, path, query and headers of r.
+func (i *Interaction) setRequest(r *http.Request) {
+	q := r.URL.Query()
+	for _, key := range ignoredQuery {
+		q.Del(key)
+	}
+	h := r.Header.Clone()
+	for _, key := range ignoredHeaders {
+		h.Del(key)
+	}
+	if len(h) == 0 {
+		h = nil
+	}
+	i.Request.Method = r.Method
+	i.Request.Path = r.URL.EscapedPath()
+	i.Request.Query = q.Encode()
+	i.Request.Header = h
+}
+
+// matches - returns true if the request of i and o are the same.
+func (i *Interaction) matches(o *Interaction) bool {
+	if i.Request.Method != o.Request.Method || i.Request.Path != o.Request.Path ||
+		i.Request.Query != o.Request.Query || len(i.Request.Header) != len(o.Request.Header) {
+		return false
+	}
+	for key, values := range i.Request.Header {
+		if !slices.Equal(values, o.Request.Header[key]) {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns the method, path and query of the request of i.
+func (i *Interaction) String() string {
+	if i.Request.Query == "" {
+		return i.Request.Method + " " + i.Request.Path
+	}
+	return i.Request.Method + " " + i.Request.Path + "?" + i.Request.Query
+}
+
+// Recorder is an http.RoundTripper recording the requests sent through
+// it and their responses to a file, one JSON Interaction per line, to
+// be replayed by a Replayer. Signatures, dates, payload hashes, session
+// tokens and SSE-C keys are not recorded. Response bodies are read in full before being returned.
+type Recorder struct {
+	next http.RoundTripper
+
+	mu  sync.Mutex
+	f   *os.File
+	enc *json.Encoder
+}
+
+// NewRecorder creates or truncates the interactions file at path and
+// returns a Recorder sending requests with next, http.DefaultTransport
+// if nil. Close flushes the file.
+func NewRecorder(path string, next http.RoundTripper) (*Recorder, error) {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	return &Recorder{next: next, f: f, enc: json.NewEncoder(f)}, nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (rec *Recorder) RoundTrip(r *http.Request) (*http.Response, error) {
+	var i Interaction
+	i.setRequest(r)
+	resp, err := rec.next.RoundTrip(r)
+	if err == nil {
+		var body []byte
+		body, err = io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err == nil {
+			i.Response.StatusCode = resp.StatusCode
+			i.Response.Header = resp.Header
+			i.Response.Body = body
+			resp.Body = io.NopCloser(bytes.NewReader(body))
+		}
+	}
+	if err != nil {
+		i.Error = err.Error()
+	}
+
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	if werr := rec.enc.Encode(&i); werr != nil && err == nil {
+		resp.Body.Close()
+		return nil, werr
+	}
+	return resp, err
+}
+
+// Close closes the interactions file.
+func (rec *Recorder) Close() error {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	return rec.f.Close()
+}
+
+// Replayer is an http.RoundTripper answering requests with the
+// responses of a file written by a Recorder, without any network
+// access. Requests match interactions of the same method, path, query
+// and headers, ignoring signatures, dates and payload hashes. Matching
+// interactions are replayed once each, in the recorded order.
+type Replayer struct {
+	mu           sync.Mutex
+	interactions []*Interaction
+	used         []bool
+}
+
+// NewReplayer returns a Replayer of the interactions file at path.
+func NewReplayer(path string) (*Replayer, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	rep := &Replayer{}
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 1<<30)
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		i := &Interaction{}
+		if err = json.Unmarshal(scanner.Bytes(), i); err != nil {
+			return nil, fmt.Errorf("s3test: %s:%d: %w", path, line, err)
+		}
+		rep.interactions = append(rep.interactions, i)
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+	rep.used = make([]bool, len(rep.interactions))
+	return rep, nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (rep *Replayer) RoundTrip(r *http.Request) (*http.Response, error) {
+	// The request body is consumed as a real transport would.
+	if r.Body != nil {
+		io.Copy(io.Discard, r.Body)
+		r.Body.Close()
+	}
+	var want Interaction
+	want.setRequest(r)
+
+	rep.mu.Lock()
+	var i *Interaction
+	for n, candidate := range rep.interactions {
+		if !rep.used[n] && candidate.matches(&want) {
+			rep.used[n] = true
+			i = candidate
+			break
+		}
+	}
+	rep.mu.Unlock()
+	if i == nil {
+		return nil, fmt.Errorf("%w: %s", ErrNoInteraction, &want)
+	}
+	if i.Error != "" {
+		return nil, errors.New(i.Error)
+	}
+
+	resp := &http.Response{
+		Status:        strconv.Itoa(i.Response.StatusCode) + " " + http.StatusText(i.Response.StatusCode),
+		StatusCode:    i.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        i.Response.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(i.Response.Body)),
+		ContentLength: int64(len(i.Response.Body)),
+		Request:       r,
+	}
+	if resp.Header == nil {
+		resp.Header = make(http.Header)
+	}
+	if r.Method == http.MethodHead {
+		resp.ContentLength = -1
+		if n, err := strconv.ParseInt(resp.Header.Get("Content-Length"), 10, 64); err == nil {
+			resp.ContentLength = n
+		}
+	}
+	return resp, nil
+}
+
+// Remaining returns the number of recorded interactions not replayed
+// yet, tests may expect zero once done.
+func (rep *Replayer) Remaining() int {
+	rep.mu.Lock()
+	defer rep.mu.Unlock()
+	n := 0
+	for _, used := range rep.used {
+		if !used {
+			n++
+		}
+	}
+	return n
+}
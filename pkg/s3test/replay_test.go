@@ -0,0 +1,184 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package s3test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// runScenario - runs requests against the server of client and returns
+// a summary of their results.
+func runScenario(t *testing.T, client *minio.Client) []string {
+	t.Helper()
+	ctx := context.Background()
+	var results []string
+	if err := client.MakeBucket(ctx, "bucket", minio.MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"a", "b"} {
+		info, err := client.PutObject(ctx, "bucket", key, strings.NewReader("data of "+key), -1, minio.PutObjectOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		results = append(results, info.ETag)
+	}
+	results = append(results, string(readObject(t, client, "bucket", "a", minio.GetObjectOptions{})))
+	for obj := range client.ListObjects(ctx, "bucket", minio.ListObjectsOptions{}) {
+		if obj.Err != nil {
+			t.Fatal(obj.Err)
+		}
+		results = append(results, fmt.Sprint(obj.Key, obj.Size))
+	}
+	if err := client.RemoveObject(ctx, "bucket", "a", minio.RemoveObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	_, err := client.StatObject(ctx, "bucket", "a", minio.StatObjectOptions{})
+	return append(results, minio.ToErrorResponse(err).Code)
+}
+
+func TestRecordReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "interactions.jsonl")
+
+	srv := NewServer(nil)
+	rec, err := NewRecorder(path, srv.srv.Client().Transport)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := minio.New(srv.Endpoint(), &minio.Options{
+		Creds:     credentials.NewStaticV4(DefaultAccessKey, DefaultSecretKey, ""),
+		Region:    DefaultRegion,
+		Transport: rec,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	recorded := runScenario(t, client)
+	srv.Close()
+	if err = rec.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(data, []byte("Signature")) || bytes.Contains(data, []byte(DefaultAccessKey+"/")) {
+		t.Fatal("signatures must not be recorded")
+	}
+
+	// Replayed with other credentials, after the server is gone.
+	rep, err := NewReplayer(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err = minio.New(srv.Endpoint(), &minio.Options{
+		Creds:      credentials.NewStaticV4("other", "other-secret", ""),
+		Region:     DefaultRegion,
+		Transport:  rep,
+		MaxRetries: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if replayed := runScenario(t, client); fmt.Sprint(replayed) != fmt.Sprint(recorded) {
+		t.Fatalf("expected %v, got %v", recorded, replayed)
+	}
+	if n := rep.Remaining(); n != 0 {
+		t.Fatalf("expected all interactions to be replayed, %d remaining", n)
+	}
+
+	_, err = client.StatObject(context.Background(), "bucket", "b", minio.StatObjectOptions{})
+	if !errors.Is(err, ErrNoInteraction) {
+		t.Fatalf("expected ErrNoInteraction, got %v", err)
+	}
+}
+
+func TestReplayerMatchesHeaders(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "interactions.jsonl")
+	var i Interaction
+	i.Request.Method = http.MethodGet
+	i.Request.Path = "/bucket/object"
+	i.Request.Header = http.Header{"Range": {"bytes=0-1"}}
+	i.Response.StatusCode = http.StatusPartialContent
+	i.Response.Body = []byte("ab")
+	line, err := json.Marshal(&i)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(path, append(line, '\n'), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	rep, err := NewReplayer(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, "http://localhost/bucket/object", nil)
+	req.Header.Set("Authorization", "ignored")
+	if _, err = rep.RoundTrip(req); !errors.Is(err, ErrNoInteraction) {
+		t.Fatalf("expected ErrNoInteraction without Range, got %v", err)
+	}
+	req.Header.Set("Range", "bytes=0-1")
+	req.Header.Set("X-Amz-Date", "20250101T000000Z")
+	resp, err := rep.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusPartialContent || resp.ContentLength != 2 {
+		t.Fatalf("unexpected response %+v", resp)
+	}
+}
+
+func TestRecorderOmitsSecrets(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodPut, "http://localhost/bucket/object", nil)
+	req.Header.Set("X-Amz-S3session-Token", "session-token")
+	req.Header.Set("X-Amz-Server-Side-Encryption-Customer-Algorithm", "AES256")
+	req.Header.Set("X-Amz-Server-Side-Encryption-Customer-Key", "customer-key")
+	req.Header.Set("X-Amz-Server-Side-Encryption-Customer-Key-Md5", "customer-key-md5")
+	req.Header.Set("X-Amz-Copy-Source-Server-Side-Encryption-Customer-Key", "source-key")
+	req.Header.Set("X-Amz-Copy-Source-Server-Side-Encryption-Customer-Key-Md5", "source-key-md5")
+
+	var i Interaction
+	i.setRequest(req)
+	line, err := json.Marshal(&i)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"session-token", "customer-key\"", "source-key\""} {
+		if bytes.Contains(line, []byte(secret)) {
+			t.Fatalf("expected %s not to be recorded in %s", secret, line)
+		}
+	}
+	for _, value := range []string{"AES256", "customer-key-md5", "source-key-md5"} {
+		if !bytes.Contains(line, []byte(value)) {
+			t.Fatalf("expected %s to be recorded in %s", value, line)
+		}
+	}
+}
@@ -0,0 +1,384 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package s3test provides an in-memory S3 compatible server to unit
+// test applications built on minio-go against a real minio.Client,
+// without running a MinIO server.
+//
+//	srv := s3test.NewServer(nil)
+//	defer srv.Close()
+//	client, err := srv.Client()
+//
+// The server implements the subset of the S3 API used by minio-go:
+// buckets, put, get, head, copy and delete of objects, ListObjectsV2
+// and ListObjects, versioning, multipart uploads, tagging, object
+// lock, checksums and signature V4 verification of headers, presigned
+// URLs and streaming payloads. All state is kept in memory.
+//
+// Recorder and Replayer capture the requests of a client against a real
+// server once, then replay them offline and deterministically.
+// FaultTransport injects error responses, connection resets, truncated
+// bodies and delays to exercise retries and error handling.
+package s3test
+
+import (
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// Default credentials and region of a Server.
+const (
+	DefaultAccessKey = "s3test"
+	DefaultSecretKey = "s3test-secret"
+	DefaultRegion    = "us-east-1"
+)
+
+// Options configure a Server, zero fields use the defaults.
+type Options struct {
+	// Credentials requests are signed with.
+	AccessKey string
+	SecretKey string
+
+	// Region of the server and all its buckets.
+	Region string
+
+	// TLS serves HTTPS with a self-signed certificate trusted by the
+	// clients returned by Server.Client.
+	TLS bool
+}
+
+// Server is an in-memory S3 compatible server listening on a local
+// address. It is safe for concurrent use.
+type Server struct {
+	accessKey string
+	secretKey string
+	region    string
+
+	srv       *httptest.Server
+	requestID atomic.Uint64
+
+	mu      sync.Mutex
+	buckets map[string]*bucket
+}
+
+// NewServer starts a Server, opts may be nil. Close stops it.
+func NewServer(opts *Options) *Server {
+	if opts == nil {
+		opts = &Options{}
+	}
+	s := &Server{
+		accessKey: opts.AccessKey,
+		secretKey: opts.SecretKey,
+		region:    opts.Region,
+		buckets:   make(map[string]*bucket),
+	}
+	if s.accessKey == "" {
+		s.accessKey = DefaultAccessKey
+	}
+	if s.secretKey == "" {
+		s.secretKey = DefaultSecretKey
+	}
+	if s.region == "" {
+		s.region = DefaultRegion
+	}
+	if opts.TLS {
+		s.srv = httptest.NewTLSServer(s)
+	} else {
+		s.srv = httptest.NewServer(s)
+	}
+	return s
+}
+
+// Endpoint returns the host and port of the server, as passed to
+// minio.New.
+func (s *Server) Endpoint() string {
+	return s.srv.Listener.Addr().String()
+}
+
+// URL returns the base URL of the server.
+func (s *Server) URL() string {
+	return s.srv.URL
+}
+
+// Client returns a client of the server using its credentials, with
+// trailing checksum headers enabled.
+func (s *Server) Client() (*minio.Client, error) {
+	return minio.New(s.Endpoint(), &minio.Options{
+		Creds:     credentials.NewStaticV4(s.accessKey, s.secretKey, ""),
+		Secure:    s.srv.TLS != nil,
+		Transport: s.srv.Client().Transport,
+		Region:    s.region,
+
+		TrailingHeaders: true,
+	})
+}
+
+// Close stops the server and releases all its objects.
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// ServeHTTP implements http.Handler, so the server can also be mounted
+// on a custom listener.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("x-amz-request-id", strconv.FormatUint(s.requestID.Add(1), 16))
+	w.Header().Set("Server", "s3test")
+
+	req, err := s.authenticate(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	switch {
+	case req.bucket == "":
+		if r.Method != http.MethodGet {
+			s.writeError(w, r, errMethodNotAllowed)
+			return
+		}
+		s.listBuckets(w, req)
+	case req.object == "":
+		s.serveBucket(w, req)
+	default:
+		s.serveObject(w, req)
+	}
+}
+
+// serveBucket - routes the requests on a bucket.
+func (s *Server) serveBucket(w http.ResponseWriter, r *request) {
+	q := r.URL.Query()
+	switch r.Method {
+	case http.MethodPut:
+		switch {
+		case q.Has("versioning"):
+			s.putBucketVersioning(w, r)
+		case q.Has("object-lock"):
+			s.putObjectLockConfig(w, r)
+		case q.Has("tagging"):
+			s.putBucketTagging(w, r)
+		case len(q) == 0:
+			s.makeBucket(w, r)
+		default:
+			s.writeError(w, r.Request, errNotImplemented)
+		}
+	case http.MethodGet:
+		switch {
+		case q.Has("location"):
+			s.getBucketLocation(w, r)
+		case q.Has("versioning"):
+			s.getBucketVersioning(w, r)
+		case q.Has("object-lock"):
+			s.getObjectLockConfig(w, r)
+		case q.Has("tagging"):
+			s.getBucketTagging(w, r)
+		case q.Has("versions"):
+			s.listObjectVersions(w, r)
+		case q.Has("uploads"):
+			s.listMultipartUploads(w, r)
+		case q.Get("list-type") == "2":
+			s.listObjectsV2(w, r)
+		default:
+			s.listObjects(w, r)
+		}
+	case http.MethodHead:
+		s.headBucket(w, r)
+	case http.MethodDelete:
+		switch {
+		case q.Has("tagging"):
+			s.deleteBucketTagging(w, r)
+		case len(q) == 0:
+			s.deleteBucket(w, r)
+		default:
+			s.writeError(w, r.Request, errNotImplemented)
+		}
+	case http.MethodPost:
+		if !q.Has("delete") {
+			s.writeError(w, r.Request, errNotImplemented)
+			return
+		}
+		s.deleteObjects(w, r)
+	default:
+		s.writeError(w, r.Request, errMethodNotAllowed)
+	}
+}
+
+// serveObject - routes the requests on an object.
+func (s *Server) serveObject(w http.ResponseWriter, r *request) {
+	q := r.URL.Query()
+	switch r.Method {
+	case http.MethodPut:
+		switch {
+		case q.Has("uploadId"):
+			s.putObjectPart(w, r)
+		case q.Has("tagging"):
+			s.putObjectTagging(w, r)
+		case q.Has("retention"):
+			s.putObjectRetention(w, r)
+		case q.Has("legal-hold"):
+			s.putObjectLegalHold(w, r)
+		case r.Header.Get("X-Amz-Copy-Source") != "":
+			s.copyObject(w, r)
+		default:
+			s.putObject(w, r)
+		}
+	case http.MethodGet:
+		switch {
+		case q.Has("uploadId"):
+			s.listObjectParts(w, r)
+		case q.Has("tagging"):
+			s.getObjectTagging(w, r)
+		case q.Has("retention"):
+			s.getObjectRetention(w, r)
+		case q.Has("legal-hold"):
+			s.getObjectLegalHold(w, r)
+		default:
+			s.getObject(w, r, false)
+		}
+	case http.MethodHead:
+		s.getObject(w, r, true)
+	case http.MethodDelete:
+		switch {
+		case q.Has("uploadId"):
+			s.abortMultipartUpload(w, r)
+		case q.Has("tagging"):
+			s.deleteObjectTagging(w, r)
+		default:
+			s.deleteObject(w, r)
+		}
+	case http.MethodPost:
+		switch {
+		case q.Has("uploads"):
+			s.newMultipartUpload(w, r)
+		case q.Has("uploadId"):
+			s.completeMultipartUpload(w, r)
+		default:
+			s.writeError(w, r.Request, errNotImplemented)
+		}
+	default:
+		s.writeError(w, r.Request, errMethodNotAllowed)
+	}
+}
+
+// apiError - an S3 error response.
+type apiError struct {
+	status  int
+	code    string
+	message string
+	// Region of region mismatch errors.
+	region string
+}
+
+func (e *apiError) Error() string {
+	return e.code + ": " + e.message
+}
+
+// Errors returned by the server.
+var (
+	errBadDigest              = &apiError{http.StatusBadRequest, "BadDigest", "The Content-MD5 or checksum you specified did not match what we received.", ""}
+	errBucketAlreadyOwned     = &apiError{http.StatusConflict, "BucketAlreadyOwnedByYou", "Your previous request to create the named bucket succeeded and you already own it.", ""}
+	errBucketNotEmpty         = &apiError{http.StatusConflict, "BucketNotEmpty", "The bucket you tried to delete is not empty.", ""}
+	errEntityTooSmall         = &apiError{http.StatusBadRequest, "EntityTooSmall", "Your proposed upload is smaller than the minimum allowed object size.", ""}
+	errExpiredPresign         = &apiError{http.StatusForbidden, "AccessDenied", "Request has expired.", ""}
+	errIncompleteBody         = &apiError{http.StatusBadRequest, "IncompleteBody", "You did not provide the number of bytes specified by the Content-Length HTTP header.", ""}
+	errInvalidAccessKeyID     = &apiError{http.StatusForbidden, "InvalidAccessKeyId", "The Access Key Id you provided does not exist in our records.", ""}
+	errInvalidArgument        = &apiError{http.StatusBadRequest, "InvalidArgument", "Invalid argument.", ""}
+	errInvalidBucketName      = &apiError{http.StatusBadRequest, "InvalidBucketName", "The specified bucket is not valid.", ""}
+	errInvalidBucketState     = &apiError{http.StatusConflict, "InvalidBucketState", "Object Lock configuration cannot be enabled on existing buckets.", ""}
+	errInvalidDigest          = &apiError{http.StatusBadRequest, "InvalidDigest", "The Content-MD5 you specified is not valid.", ""}
+	errInvalidPart            = &apiError{http.StatusBadRequest, "InvalidPart", "One or more of the specified parts could not be found.", ""}
+	errInvalidPartOrder       = &apiError{http.StatusBadRequest, "InvalidPartOrder", "The list of parts was not in ascending order.", ""}
+	errInvalidRange           = &apiError{http.StatusRequestedRangeNotSatisfiable, "InvalidRange", "The requested range is not satisfiable.", ""}
+	errInvalidRequest         = &apiError{http.StatusBadRequest, "InvalidRequest", "Bucket is missing Object Lock Configuration.", ""}
+	errMalformedXML           = &apiError{http.StatusBadRequest, "MalformedXML", "The XML you provided was not well-formed or did not validate against our published schema.", ""}
+	errMethodNotAllowed       = &apiError{http.StatusMethodNotAllowed, "MethodNotAllowed", "The specified method is not allowed against this resource.", ""}
+	errMissingAuth            = &apiError{http.StatusForbidden, "AccessDenied", "Anonymous requests are not allowed.", ""}
+	errNoSuchBucket           = &apiError{http.StatusNotFound, "NoSuchBucket", "The specified bucket does not exist.", ""}
+	errNoSuchKey              = &apiError{http.StatusNotFound, "NoSuchKey", "The specified key does not exist.", ""}
+	errNoSuchLockConfig       = &apiError{http.StatusNotFound, "ObjectLockConfigurationNotFoundError", "Object Lock configuration does not exist for this bucket.", ""}
+	errNoSuchTagSet           = &apiError{http.StatusNotFound, "NoSuchTagSet", "The TagSet does not exist.", ""}
+	errNoSuchUpload           = &apiError{http.StatusNotFound, "NoSuchUpload", "The specified multipart upload does not exist.", ""}
+	errNoSuchVersion          = &apiError{http.StatusNotFound, "NoSuchVersion", "The specified version does not exist.", ""}
+	errNoSuchObjectLock       = &apiError{http.StatusNotFound, "NoSuchObjectLockConfiguration", "The specified object does not have an ObjectLock configuration.", ""}
+	errNotImplemented         = &apiError{http.StatusNotImplemented, "NotImplemented", "A header or query you provided implies functionality that is not implemented.", ""}
+	errNotModified            = &apiError{http.StatusNotModified, "NotModified", "Not Modified.", ""}
+	errObjectLocked           = &apiError{http.StatusForbidden, "AccessDenied", "Object is WORM protected and cannot be overwritten or deleted.", ""}
+	errPreconditionFailed     = &apiError{http.StatusPreconditionFailed, "PreconditionFailed", "At least one of the preconditions you specified did not hold.", ""}
+	errSignatureDoesNotMatch  = &apiError{http.StatusForbidden, "SignatureDoesNotMatch", "The request signature we calculated does not match the signature you provided.", ""}
+	errContentSHA256Mismatch  = &apiError{http.StatusBadRequest, "XAmzContentSHA256Mismatch", "The provided 'x-amz-content-sha256' header does not match what was computed.", ""}
+	errUnsupportedSignature   = &apiError{http.StatusBadRequest, "InvalidRequest", "Only signature version 4 is supported.", ""}
+	errMalformedAuthorization = &apiError{http.StatusBadRequest, "AuthorizationHeaderMalformed", "The authorization header is malformed.", ""}
+)
+
+// errorResponse - the XML body of an error response.
+type errorResponse struct {
+	XMLName    xml.Name `xml:"Error"`
+	Code       string
+	Message    string
+	BucketName string `xml:",omitempty"`
+	Key        string `xml:",omitempty"`
+	Resource   string
+	Region     string `xml:",omitempty"`
+	RequestID  string `xml:"RequestId"`
+}
+
+// writeError - writes err, an *apiError or any other error reported
+// as an internal error.
+func (s *Server) writeError(w http.ResponseWriter, r *http.Request, err error) {
+	e, ok := err.(*apiError)
+	if !ok {
+		e = &apiError{http.StatusInternalServerError, "InternalError", err.Error(), ""}
+	}
+	if e.status == http.StatusNotModified || r.Method == http.MethodHead {
+		if e == errNoSuchKey || e == errNoSuchVersion {
+			w.Header().Set("x-amz-error-code", e.code)
+		}
+		w.WriteHeader(e.status)
+		return
+	}
+	bucket, object, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	writeXML(w, e.status, errorResponse{
+		Code:       e.code,
+		Message:    e.message,
+		BucketName: bucket,
+		Key:        object,
+		Resource:   r.URL.Path,
+		Region:     e.region,
+		RequestID:  w.Header().Get("x-amz-request-id"),
+	})
+}
+
+// writeXML - writes v as the XML body of a response with status.
+func writeXML(w http.ResponseWriter, status int, v any) {
+	body, err := xml.Marshal(v)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	w.Header().Set("Content-Length", strconv.Itoa(len(xml.Header)+len(body)))
+	w.WriteHeader(status)
+	w.Write([]byte(xml.Header))
+	w.Write(body)
+}
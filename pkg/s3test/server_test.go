@@ -0,0 +1,492 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package s3test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio-go/v7/pkg/tags"
+)
+
+func newTestClient(t *testing.T, opts *Options) (*Server, *minio.Client) {
+	t.Helper()
+	srv := NewServer(opts)
+	t.Cleanup(srv.Close)
+	client, err := srv.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return srv, client
+}
+
+func readObject(t *testing.T, client *minio.Client, bucket, object string, opts minio.GetObjectOptions) []byte {
+	t.Helper()
+	obj, err := client.GetObject(context.Background(), bucket, object, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Close()
+	data, err := io.ReadAll(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestObjects(t *testing.T) {
+	for _, tls := range []bool{false, true} {
+		t.Run(fmt.Sprintf("tls=%v", tls), func(t *testing.T) {
+			_, client := newTestClient(t, &Options{TLS: tls})
+			ctx := context.Background()
+
+			if err := client.MakeBucket(ctx, "bucket", minio.MakeBucketOptions{}); err != nil {
+				t.Fatal(err)
+			}
+			if err := client.MakeBucket(ctx, "bucket", minio.MakeBucketOptions{}); minio.ToErrorResponse(err).Code != "BucketAlreadyOwnedByYou" {
+				t.Fatalf("expected BucketAlreadyOwnedByYou, got %v", err)
+			}
+			if ok, err := client.BucketExists(ctx, "bucket"); err != nil || !ok {
+				t.Fatalf("expected bucket to exist, got %v, %v", ok, err)
+			}
+
+			data := []byte("hello, world")
+			info, err := client.PutObject(ctx, "bucket", "dir/object", bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{
+				ContentType:  "text/plain",
+				UserMetadata: map[string]string{"Color": "blue"},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			stat, err := client.StatObject(ctx, "bucket", "dir/object", minio.StatObjectOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if stat.Size != int64(len(data)) || stat.ETag != info.ETag || stat.ContentType != "text/plain" || stat.UserMetadata["Color"] != "blue" {
+				t.Fatalf("unexpected object info %+v", stat)
+			}
+			if got := readObject(t, client, "bucket", "dir/object", minio.GetObjectOptions{}); !bytes.Equal(got, data) {
+				t.Fatalf("expected %q, got %q", data, got)
+			}
+
+			var opts minio.GetObjectOptions
+			opts.SetRange(7, 11)
+			if got := readObject(t, client, "bucket", "dir/object", opts); string(got) != "world" {
+				t.Fatalf("expected %q, got %q", "world", got)
+			}
+
+			opts = minio.GetObjectOptions{}
+			opts.SetMatchETag("wrong")
+			if _, err = client.StatObject(ctx, "bucket", "dir/object", minio.StatObjectOptions(opts)); err == nil {
+				t.Fatal("expected precondition failure")
+			}
+
+			if err = client.RemoveBucket(ctx, "bucket"); minio.ToErrorResponse(err).Code != "BucketNotEmpty" {
+				t.Fatalf("expected BucketNotEmpty, got %v", err)
+			}
+			if err = client.RemoveObject(ctx, "bucket", "dir/object", minio.RemoveObjectOptions{}); err != nil {
+				t.Fatal(err)
+			}
+			if _, err = client.StatObject(ctx, "bucket", "dir/object", minio.StatObjectOptions{}); minio.ToErrorResponse(err).Code != "NoSuchKey" {
+				t.Fatalf("expected NoSuchKey, got %v", err)
+			}
+			if err = client.RemoveBucket(ctx, "bucket"); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestListObjects(t *testing.T) {
+	_, client := newTestClient(t, nil)
+	ctx := context.Background()
+	if err := client.MakeBucket(ctx, "bucket", minio.MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	keys := []string{"a", "b/1", "b/2", "c/d/1", "e"}
+	for _, key := range keys {
+		if _, err := client.PutObject(ctx, "bucket", key, strings.NewReader(key), int64(len(key)), minio.PutObjectOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	testCases := []struct {
+		opts minio.ListObjectsOptions
+		want []string
+	}{
+		{minio.ListObjectsOptions{Recursive: true}, keys},
+		{minio.ListObjectsOptions{Recursive: true, MaxKeys: 2}, keys},
+		{minio.ListObjectsOptions{}, []string{"a", "e", "b/", "c/"}},
+		{minio.ListObjectsOptions{MaxKeys: 1}, []string{"a", "b/", "c/", "e"}},
+		{minio.ListObjectsOptions{Prefix: "b/"}, []string{"b/1", "b/2"}},
+		{minio.ListObjectsOptions{Recursive: true, StartAfter: "b/1"}, []string{"b/2", "c/d/1", "e"}},
+		{minio.ListObjectsOptions{Recursive: true, UseV1: true, MaxKeys: 2}, keys},
+		{minio.ListObjectsOptions{UseV1: true, MaxKeys: 1}, []string{"a", "b/", "c/", "e"}},
+	}
+	for i, testCase := range testCases {
+		var got []string
+		for obj := range client.ListObjects(ctx, "bucket", testCase.opts) {
+			if obj.Err != nil {
+				t.Fatalf("Test %d: %v", i+1, obj.Err)
+			}
+			got = append(got, obj.Key)
+		}
+		if fmt.Sprint(got) != fmt.Sprint(testCase.want) {
+			t.Errorf("Test %d: expected %v, got %v", i+1, testCase.want, got)
+		}
+	}
+}
+
+func TestVersioning(t *testing.T) {
+	_, client := newTestClient(t, nil)
+	ctx := context.Background()
+	if err := client.MakeBucket(ctx, "bucket", minio.MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.EnableVersioning(ctx, "bucket"); err != nil {
+		t.Fatal(err)
+	}
+
+	var versions []string
+	for _, data := range []string{"v1", "v2"} {
+		info, err := client.PutObject(ctx, "bucket", "object", strings.NewReader(data), int64(len(data)), minio.PutObjectOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		versions = append(versions, info.VersionID)
+	}
+	if err := client.RemoveObject(ctx, "bucket", "object", minio.RemoveObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.StatObject(ctx, "bucket", "object", minio.StatObjectOptions{}); minio.ToErrorResponse(err).Code != "NoSuchKey" {
+		t.Fatalf("expected NoSuchKey, got %v", err)
+	}
+	if got := readObject(t, client, "bucket", "object", minio.GetObjectOptions{VersionID: versions[0]}); string(got) != "v1" {
+		t.Fatalf("expected v1, got %q", got)
+	}
+
+	var listed []string
+	for obj := range client.ListObjects(ctx, "bucket", minio.ListObjectsOptions{WithVersions: true, MaxKeys: 1}) {
+		if obj.Err != nil {
+			t.Fatal(obj.Err)
+		}
+		listed = append(listed, fmt.Sprintf("%s:%v:%v", obj.VersionID, obj.IsDeleteMarker, obj.IsLatest))
+	}
+	if len(listed) != 3 || !strings.HasSuffix(listed[0], ":true:true") ||
+		listed[1] != versions[1]+":false:false" || listed[2] != versions[0]+":false:false" {
+		t.Fatalf("unexpected versions %v", listed)
+	}
+
+	for obj := range client.RemoveObjects(ctx, "bucket", client.ListObjects(ctx, "bucket", minio.ListObjectsOptions{WithVersions: true}), minio.RemoveObjectsOptions{}) {
+		t.Fatal(obj.Err)
+	}
+	if err := client.RemoveBucket(ctx, "bucket"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMultipartUpload(t *testing.T) {
+	_, client := newTestClient(t, nil)
+	ctx := context.Background()
+	if err := client.MakeBucket(ctx, "bucket", minio.MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	data := bytes.Repeat([]byte("0123456789abcdef"), (11<<20)/16)
+
+	for _, checksum := range []minio.ChecksumType{minio.ChecksumCRC32C, minio.ChecksumSHA256, minio.ChecksumFullObjectCRC32} {
+		t.Run(checksum.String(), func(t *testing.T) {
+			info, err := client.PutObject(ctx, "bucket", "object", bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{
+				PartSize:     5 << 20,
+				AutoChecksum: checksum,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.HasSuffix(info.ETag, "-3") {
+				t.Fatalf("expected multipart ETag, got %s", info.ETag)
+			}
+			stat, err := client.StatObject(ctx, "bucket", "object", minio.StatObjectOptions{Checksum: true})
+			if err != nil {
+				t.Fatal(err)
+			}
+			mode := "COMPOSITE"
+			if checksum.FullObjectRequested() {
+				mode = "FULL_OBJECT"
+			}
+			if stat.ETag != info.ETag || stat.Size != int64(len(data)) || stat.ChecksumMode != mode {
+				t.Fatalf("unexpected object info %+v", stat)
+			}
+			if got := readObject(t, client, "bucket", "object", minio.GetObjectOptions{}); !bytes.Equal(got, data) {
+				t.Fatal("unexpected object data")
+			}
+			opts := minio.GetObjectOptions{PartNumber: 3}
+			if got := readObject(t, client, "bucket", "object", opts); !bytes.Equal(got, data[10<<20:]) {
+				t.Fatal("unexpected part data")
+			}
+		})
+	}
+
+	// Abandoned uploads are listed and can be removed.
+	core := minio.Core{Client: client}
+	uploadID, err := core.NewMultipartUpload(ctx, "bucket", "incomplete", minio.PutObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = core.PutObjectPart(ctx, "bucket", "incomplete", uploadID, 1, bytes.NewReader(data[:10]), 10, minio.PutObjectPartOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	parts, err := core.ListObjectParts(ctx, "bucket", "incomplete", uploadID, 0, 0)
+	if err != nil || len(parts.ObjectParts) != 1 || parts.ObjectParts[0].Size != 10 {
+		t.Fatalf("unexpected parts %+v, %v", parts, err)
+	}
+	var uploads []string
+	for upload := range client.ListIncompleteUploads(ctx, "bucket", "", true) {
+		if upload.Err != nil {
+			t.Fatal(upload.Err)
+		}
+		uploads = append(uploads, upload.Key)
+	}
+	if fmt.Sprint(uploads) != "[incomplete]" {
+		t.Fatalf("unexpected uploads %v", uploads)
+	}
+	if err = client.RemoveIncompleteUpload(ctx, "bucket", "incomplete"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = core.ListObjectParts(ctx, "bucket", "incomplete", uploadID, 0, 0); minio.ToErrorResponse(err).Code != "NoSuchUpload" {
+		t.Fatalf("expected NoSuchUpload, got %v", err)
+	}
+}
+
+func TestCopyObject(t *testing.T) {
+	_, client := newTestClient(t, nil)
+	ctx := context.Background()
+	if err := client.MakeBucket(ctx, "bucket", minio.MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	data := bytes.Repeat([]byte("x"), 6<<20)
+	if _, err := client.PutObject(ctx, "bucket", "src", bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{
+		UserMetadata: map[string]string{"Color": "blue"},
+		UserTags:     map[string]string{"team": "a"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.CopyObject(ctx, minio.CopyDestOptions{Bucket: "bucket", Object: "dst"}, minio.CopySrcOptions{Bucket: "bucket", Object: "src"}); err != nil {
+		t.Fatal(err)
+	}
+	stat, err := client.StatObject(ctx, "bucket", "dst", minio.StatObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stat.UserMetadata["Color"] != "blue" || stat.UserTagCount != 1 {
+		t.Fatalf("unexpected object info %+v", stat)
+	}
+
+	// Composed from two ranges of the source with server side part copies.
+	if _, err = client.ComposeObject(ctx, minio.CopyDestOptions{Bucket: "bucket", Object: "composed"},
+		minio.CopySrcOptions{Bucket: "bucket", Object: "src", MatchRange: true, Start: 0, End: 5<<20 - 1},
+		minio.CopySrcOptions{Bucket: "bucket", Object: "dst"},
+	); err != nil {
+		t.Fatal(err)
+	}
+	if got := readObject(t, client, "bucket", "composed", minio.GetObjectOptions{}); !bytes.Equal(got, bytes.Repeat([]byte("x"), 11<<20)) {
+		t.Fatalf("unexpected composed object of %d bytes", len(got))
+	}
+
+	_, err = client.CopyObject(ctx, minio.CopyDestOptions{Bucket: "bucket", Object: "dst"}, minio.CopySrcOptions{Bucket: "bucket", Object: "src", MatchETag: "wrong"})
+	if minio.ToErrorResponse(err).Code != "PreconditionFailed" {
+		t.Fatalf("expected PreconditionFailed, got %v", err)
+	}
+}
+
+func TestTagging(t *testing.T) {
+	_, client := newTestClient(t, nil)
+	ctx := context.Background()
+	if err := client.MakeBucket(ctx, "bucket", minio.MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.PutObject(ctx, "bucket", "object", strings.NewReader("x"), 1, minio.PutObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	otags, err := tags.NewTags(map[string]string{"k1": "v1", "k2": "v2"}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = client.PutObjectTagging(ctx, "bucket", "object", otags, minio.PutObjectTaggingOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	got, err := client.GetObjectTagging(ctx, "bucket", "object", minio.GetObjectTaggingOptions{})
+	if err != nil || got.String() != otags.String() {
+		t.Fatalf("expected %v, got %v, %v", otags, got, err)
+	}
+	if err = client.RemoveObjectTagging(ctx, "bucket", "object", minio.RemoveObjectTaggingOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if got, err = client.GetObjectTagging(ctx, "bucket", "object", minio.GetObjectTaggingOptions{}); err != nil || got.Count() != 0 {
+		t.Fatalf("expected no tags, got %v, %v", got, err)
+	}
+
+	btags, err := tags.NewTags(map[string]string{"env": "test"}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = client.SetBucketTagging(ctx, "bucket", btags); err != nil {
+		t.Fatal(err)
+	}
+	if got, err = client.GetBucketTagging(ctx, "bucket"); err != nil || got.String() != btags.String() {
+		t.Fatalf("expected %v, got %v, %v", btags, got, err)
+	}
+	if err = client.RemoveBucketTagging(ctx, "bucket"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.GetBucketTagging(ctx, "bucket"); minio.ToErrorResponse(err).Code != "NoSuchTagSet" {
+		t.Fatalf("expected NoSuchTagSet, got %v", err)
+	}
+}
+
+func TestObjectLock(t *testing.T) {
+	_, client := newTestClient(t, nil)
+	ctx := context.Background()
+	if err := client.MakeBucket(ctx, "plain", minio.MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.MakeBucket(ctx, "locked", minio.MakeBucketOptions{ObjectLocking: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	until := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	opts := minio.PutObjectOptions{Mode: minio.Governance, RetainUntilDate: until}
+	if _, err := client.PutObject(ctx, "plain", "object", strings.NewReader("x"), 1, opts); err == nil {
+		t.Fatal("expected object lock on a bucket without object lock to fail")
+	}
+	info, err := client.PutObject(ctx, "locked", "object", strings.NewReader("x"), 1, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mode, retainUntil, err := client.GetObjectRetention(ctx, "locked", "object", info.VersionID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *mode != minio.Governance || !retainUntil.Equal(until) {
+		t.Fatalf("unexpected retention %v until %v", *mode, retainUntil)
+	}
+
+	remove := minio.RemoveObjectOptions{VersionID: info.VersionID}
+	if err = client.RemoveObject(ctx, "locked", "object", remove); minio.ToErrorResponse(err).Code != "AccessDenied" {
+		t.Fatalf("expected AccessDenied, got %v", err)
+	}
+	on := minio.LegalHoldEnabled
+	if err = client.PutObjectLegalHold(ctx, "locked", "object", minio.PutObjectLegalHoldOptions{VersionID: info.VersionID, Status: &on}); err != nil {
+		t.Fatal(err)
+	}
+	remove.GovernanceBypass = true
+	if err = client.RemoveObject(ctx, "locked", "object", remove); minio.ToErrorResponse(err).Code != "AccessDenied" {
+		t.Fatalf("expected AccessDenied under legal hold, got %v", err)
+	}
+	off := minio.LegalHoldDisabled
+	if err = client.PutObjectLegalHold(ctx, "locked", "object", minio.PutObjectLegalHoldOptions{VersionID: info.VersionID, Status: &off}); err != nil {
+		t.Fatal(err)
+	}
+	if err = client.RemoveObject(ctx, "locked", "object", remove); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestChecksums(t *testing.T) {
+	_, client := newTestClient(t, nil)
+	ctx := context.Background()
+	if err := client.MakeBucket(ctx, "bucket", minio.MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	data := []byte("checksummed data")
+	for _, checksum := range checksumTypes {
+		info, err := client.PutObject(ctx, "bucket", "object", bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{
+			Checksum: checksum,
+		})
+		if err != nil {
+			t.Fatalf("%v: %v", checksum, err)
+		}
+		stat, err := client.StatObject(ctx, "bucket", "object", minio.StatObjectOptions{Checksum: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := map[minio.ChecksumType]string{
+			minio.ChecksumCRC32:     stat.ChecksumCRC32,
+			minio.ChecksumCRC32C:    stat.ChecksumCRC32C,
+			minio.ChecksumSHA1:      stat.ChecksumSHA1,
+			minio.ChecksumSHA256:    stat.ChecksumSHA256,
+			minio.ChecksumCRC64NVME: stat.ChecksumCRC64NVME,
+		}[checksum]
+		if want := checksum.EncodeToString(data); got != want || info.ETag != stat.ETag {
+			t.Fatalf("%v: expected checksum %s, got %s", checksum, want, got)
+		}
+	}
+}
+
+func TestAuthentication(t *testing.T) {
+	srv, client := newTestClient(t, nil)
+	ctx := context.Background()
+	if err := client.MakeBucket(ctx, "bucket", minio.MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.PutObject(ctx, "bucket", "object", strings.NewReader("x"), 1, minio.PutObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	wrong, err := minio.New(srv.Endpoint(), &minio.Options{Creds: credentials.NewStaticV4(DefaultAccessKey, "wrong", "")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = wrong.StatObject(ctx, "bucket", "object", minio.StatObjectOptions{}); minio.ToErrorResponse(err).StatusCode != http.StatusForbidden {
+		t.Fatalf("expected signature mismatch, got %v", err)
+	}
+
+	u, err := client.PresignedGetObject(ctx, "bucket", "object", time.Minute, url.Values{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := http.Get(u.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || string(body) != "x" {
+		t.Fatalf("unexpected presigned response %d %q", resp.StatusCode, body)
+	}
+
+	resp, err = http.Get(srv.URL() + "/bucket/object")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("expected anonymous request to be denied, got %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,140 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package tracing reports the requests of a minio.Client as spans of a
+// distributed tracer. Spans carry OpenTelemetry semantic convention
+// attributes, an OpenTelemetry tracer is adapted with a few lines
+// without this package depending on it:
+//
+//	type otelTracer struct{ trace.Tracer }
+//
+//	func (t otelTracer) Start(ctx context.Context, name string) (context.Context, tracing.Span) {
+//		ctx, span := t.Tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient))
+//		return ctx, otelSpan{span}
+//	}
+//
+//	type otelSpan struct{ trace.Span }
+//
+//	func (s otelSpan) SetAttribute(key string, value any) {
+//		s.SetAttributes(attribute.String(key, fmt.Sprint(value)))
+//	}
+//
+//	func (s otelSpan) AddEvent(name string) { s.Span.AddEvent(name) }
+//
+//	func (s otelSpan) SetError(err error) {
+//		s.RecordError(err)
+//		s.SetStatus(codes.Error, err.Error())
+//	}
+//
+//	func (s otelSpan) End() { s.Span.End() }
+//
+//	client, err := minio.New(endpoint, &minio.Options{
+//		Hooks: tracing.NewHooks(otelTracer{otel.Tracer("minio-go")}),
+//	})
+package tracing
+
+import (
+	"context"
+
+	"github.com/minio/minio-go/v7"
+)
+
+// Attribute keys set on spans.
+const (
+	AttrRPCSystem        = "rpc.system"
+	AttrRPCService       = "rpc.service"
+	AttrRPCMethod        = "rpc.method"
+	AttrBucket           = "aws.s3.bucket"
+	AttrKey              = "aws.s3.key"
+	AttrHTTPMethod       = "http.request.method"
+	AttrHTTPStatusCode   = "http.response.status_code"
+	AttrHTTPResendCount  = "http.request.resend_count"
+	AttrRequestBodySize  = "http.request.body.size"
+	AttrResponseBodySize = "http.response.body.size"
+	AttrErrorType        = "error.type"
+)
+
+// Span is a single traced request.
+type Span interface {
+	SetAttribute(key string, value any)
+	AddEvent(name string)
+	SetError(err error)
+	End()
+}
+
+// Tracer starts spans, the returned context carries the span so that
+// nested requests become its children.
+type Tracer interface {
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+type spanKey struct{}
+
+// hooks - implements minio.RequestHooks on top of a Tracer.
+type hooks struct {
+	tracer Tracer
+}
+
+// NewHooks returns request hooks starting a span of tracer for every
+// request of a client, named after its S3 operation. Retries are
+// recorded as span events.
+func NewHooks(tracer Tracer) minio.RequestHooks {
+	return hooks{tracer: tracer}
+}
+
+func (h hooks) RequestStart(ctx context.Context, info minio.RequestInfo) context.Context {
+	ctx, span := h.tracer.Start(ctx, "S3."+info.Operation)
+	span.SetAttribute(AttrRPCSystem, "aws-api")
+	span.SetAttribute(AttrRPCService, "S3")
+	span.SetAttribute(AttrRPCMethod, info.Operation)
+	span.SetAttribute(AttrHTTPMethod, info.Method)
+	if info.BucketName != "" {
+		span.SetAttribute(AttrBucket, info.BucketName)
+	}
+	if info.ObjectName != "" {
+		span.SetAttribute(AttrKey, info.ObjectName)
+	}
+	return context.WithValue(ctx, spanKey{}, span)
+}
+
+func (h hooks) RequestRetry(ctx context.Context, info minio.RequestInfo) {
+	if span, ok := ctx.Value(spanKey{}).(Span); ok {
+		span.AddEvent("retry")
+	}
+}
+
+func (h hooks) RequestEnd(ctx context.Context, info minio.RequestInfo) {
+	span, ok := ctx.Value(spanKey{}).(Span)
+	if !ok {
+		return
+	}
+	if info.StatusCode != 0 {
+		span.SetAttribute(AttrHTTPStatusCode, info.StatusCode)
+	}
+	if info.Attempt > 0 {
+		span.SetAttribute(AttrHTTPResendCount, info.Attempt)
+	}
+	span.SetAttribute(AttrRequestBodySize, info.BytesSent)
+	span.SetAttribute(AttrResponseBodySize, info.BytesReceived)
+	if info.Err != nil {
+		if info.ErrorCode != "" {
+			span.SetAttribute(AttrErrorType, info.ErrorCode)
+		}
+		span.SetError(info.Err)
+	}
+	span.End()
+}
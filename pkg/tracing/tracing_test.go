@@ -0,0 +1,96 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/minio/minio-go/v7"
+)
+
+type testSpan struct {
+	name   string
+	attrs  map[string]any
+	events []string
+	err    error
+	ended  bool
+}
+
+func (s *testSpan) SetAttribute(key string, value any) { s.attrs[key] = value }
+func (s *testSpan) AddEvent(name string)               { s.events = append(s.events, name) }
+func (s *testSpan) SetError(err error)                 { s.err = err }
+func (s *testSpan) End()                               { s.ended = true }
+
+type testTracer struct {
+	spans []*testSpan
+}
+
+func (t *testTracer) Start(ctx context.Context, name string) (context.Context, Span) {
+	span := &testSpan{name: name, attrs: make(map[string]any)}
+	t.spans = append(t.spans, span)
+	return ctx, span
+}
+
+func TestHooks(t *testing.T) {
+	tracer := &testTracer{}
+	hooks := NewHooks(tracer)
+
+	info := minio.RequestInfo{
+		Operation:  "UploadPart",
+		Method:     "PUT",
+		BucketName: "bucket",
+		ObjectName: "object",
+	}
+	ctx := hooks.RequestStart(context.Background(), info)
+	info.Attempt = 1
+	info.StatusCode = 503
+	info.ErrorCode = "SlowDown"
+	hooks.RequestRetry(ctx, info)
+	info.Attempt = 2
+	info.Err = errors.New("Internal Error")
+	info.ErrorCode = "InternalError"
+	info.StatusCode = 500
+	info.BytesSent = 30
+	hooks.RequestEnd(ctx, info)
+
+	if len(tracer.spans) != 1 {
+		t.Fatalf("Expected 1 span, got %d", len(tracer.spans))
+	}
+	span := tracer.spans[0]
+	if span.name != "S3.UploadPart" || !span.ended || span.err != info.Err {
+		t.Fatalf("Unexpected span %+v", span)
+	}
+	if len(span.events) != 1 || span.events[0] != "retry" {
+		t.Fatalf("Expected a retry event, got %v", span.events)
+	}
+	for key, value := range map[string]any{
+		AttrRPCMethod:       "UploadPart",
+		AttrBucket:          "bucket",
+		AttrKey:             "object",
+		AttrHTTPStatusCode:  500,
+		AttrHTTPResendCount: 2,
+		AttrRequestBodySize: int64(30),
+		AttrErrorType:       "InternalError",
+	} {
+		if span.attrs[key] != value {
+			t.Errorf("Expected %s to be %v, got %v", key, value, span.attrs[key])
+		}
+	}
+}
@@ -0,0 +1,222 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"io"
+	"sync/atomic"
+)
+
+// ProgressEventType is the kind of a ProgressEvent.
+type ProgressEventType int
+
+// Different types of progress events.
+const (
+	// ProgressTransferStarted is sent once before any data is
+	// transferred, Size is the total size or -1 if unknown.
+	ProgressTransferStarted ProgressEventType = iota
+	// ProgressPartStarted is sent before a part is transferred, Size is
+	// the size of the part.
+	ProgressPartStarted
+	// ProgressPartCompleted is sent once a part is transferred.
+	ProgressPartCompleted
+	// ProgressPartRetried is sent when a part is sent again after Err,
+	// Bytes is the number of bytes of the part reported so far which
+	// will be transferred again.
+	ProgressPartRetried
+	// ProgressPartFailed is sent when a part could not be transferred.
+	ProgressPartFailed
+	// ProgressBytesTransferred reports Bytes more bytes transferred.
+	ProgressBytesTransferred
+	// ProgressTransferCompleted is sent once the transfer is done, Err
+	// is set if the transfer failed.
+	ProgressTransferCompleted
+)
+
+// String returns the name of the event type.
+func (t ProgressEventType) String() string {
+	switch t {
+	case ProgressTransferStarted:
+		return "TransferStarted"
+	case ProgressPartStarted:
+		return "PartStarted"
+	case ProgressPartCompleted:
+		return "PartCompleted"
+	case ProgressPartRetried:
+		return "PartRetried"
+	case ProgressPartFailed:
+		return "PartFailed"
+	case ProgressBytesTransferred:
+		return "BytesTransferred"
+	case ProgressTransferCompleted:
+		return "TransferCompleted"
+	}
+	return "Unknown"
+}
+
+// ProgressEvent describes the progress of a transfer.
+type ProgressEvent struct {
+	Type       ProgressEventType
+	BucketName string
+	ObjectName string
+
+	// PartNumber is the part the event refers to, zero for transfers
+	// done with a single request.
+	PartNumber int
+
+	Size  int64
+	Bytes int64
+	Err   error
+}
+
+// ProgressListener is notified about the progress of uploads, downloads
+// and copies. Parts are transferred concurrently, ProgressChanged must
+// be safe for concurrent use and should return quickly.
+type ProgressListener interface {
+	ProgressChanged(ProgressEvent)
+}
+
+// ProgressListenerFunc is an adapter to use a function as a
+// ProgressListener.
+type ProgressListenerFunc func(ProgressEvent)
+
+// ProgressChanged calls f(event).
+func (f ProgressListenerFunc) ProgressChanged(event ProgressEvent) {
+	f(event)
+}
+
+// transferProgress - sends the events of a single transfer, all
+// methods are no-ops without a listener.
+type transferProgress struct {
+	listener   ProgressListener
+	bucketName string
+	objectName string
+}
+
+func newTransferProgress(listener ProgressListener, bucketName, objectName string) transferProgress {
+	return transferProgress{listener: listener, bucketName: bucketName, objectName: objectName}
+}
+
+func (t transferProgress) send(event ProgressEvent) {
+	if t.listener == nil {
+		return
+	}
+	event.BucketName = t.bucketName
+	event.ObjectName = t.objectName
+	t.listener.ProgressChanged(event)
+}
+
+// started - reports the start of a transfer of size bytes.
+func (t transferProgress) started(size int64) {
+	t.send(ProgressEvent{Type: ProgressTransferStarted, Size: size})
+}
+
+// completed - reports the end of a transfer.
+func (t transferProgress) completed(err error) {
+	t.send(ProgressEvent{Type: ProgressTransferCompleted, Err: err})
+}
+
+// transferred - reports n transferred bytes of a part.
+func (t transferProgress) transferred(partNumber int, n int64) {
+	if n > 0 {
+		t.send(ProgressEvent{Type: ProgressBytesTransferred, PartNumber: partNumber, Bytes: n})
+	}
+}
+
+// part - returns the progress of a single part of size bytes.
+func (t transferProgress) part(partNumber int, size int64) *partProgress {
+	if t.listener == nil {
+		return nil
+	}
+	return &partProgress{transfer: t, partNumber: partNumber, size: size}
+}
+
+// partProgress - reports the progress of a single part. A nil
+// partProgress reports nothing.
+type partProgress struct {
+	transfer   transferProgress
+	partNumber int
+	size       int64
+
+	// Bytes reported since the last attempt.
+	sent atomic.Int64
+}
+
+func (p *partProgress) started() {
+	if p != nil {
+		p.transfer.send(ProgressEvent{Type: ProgressPartStarted, PartNumber: p.partNumber, Size: p.size})
+	}
+}
+
+// retried - reports the part being sent again, resets the bytes
+// reported for the failed attempt.
+func (p *partProgress) retried(err error) {
+	if p != nil {
+		sent := p.sent.Swap(0)
+		p.transfer.send(ProgressEvent{Type: ProgressPartRetried, PartNumber: p.partNumber, Size: p.size, Bytes: sent, Err: err})
+	}
+}
+
+// done - reports the part as completed or failed.
+func (p *partProgress) done(err error) {
+	if p == nil {
+		return
+	}
+	if err != nil {
+		p.transfer.send(ProgressEvent{Type: ProgressPartFailed, PartNumber: p.partNumber, Size: p.size, Err: err})
+		return
+	}
+	p.transfer.send(ProgressEvent{Type: ProgressPartCompleted, PartNumber: p.partNumber, Size: p.size})
+}
+
+// transferred - reports n transferred bytes.
+func (p *partProgress) transferred(n int64) {
+	if p != nil && n > 0 {
+		p.sent.Add(n)
+		p.transfer.transferred(p.partNumber, n)
+	}
+}
+
+// reader - returns source reporting all the bytes read from it.
+func (p *partProgress) reader(source io.Reader) io.Reader {
+	if p == nil || source == nil {
+		return source
+	}
+	return &progressReader{source: source, progress: p}
+}
+
+// progressReader - reports the bytes read from source as transferred.
+type progressReader struct {
+	source   io.Reader
+	progress *partProgress
+}
+
+// Seek implements io.Seeker, seeks source if it is seekable the same
+// way hookReader does.
+func (r *progressReader) Seek(offset int64, whence int) (int64, error) {
+	if seeker, ok := r.source.(io.Seeker); ok {
+		return seeker.Seek(offset, whence)
+	}
+	return 0, nil
+}
+
+func (r *progressReader) Read(b []byte) (int, error) {
+	n, err := r.source.Read(b)
+	r.progress.transferred(int64(n))
+	return n, err
+}
@@ -0,0 +1,102 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestPutObjectProgressListener(t *testing.T) {
+	data := make([]byte, 2*absMinPartSize+17)
+	rand.Read(data)
+
+	mts := &multipartTestServer{}
+	var failed sync.Once
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Fail the first attempt of part 2 after reading its body.
+		if r.URL.Query().Get("partNumber") == "2" {
+			retry := false
+			failed.Do(func() { retry = true })
+			if retry {
+				r.Body.Close()
+				w.WriteHeader(http.StatusServiceUnavailable)
+				xml.NewEncoder(w).Encode(ErrorResponse{Code: "SlowDown"})
+				return
+			}
+		}
+		mts.ServeHTTP(w, r)
+	}))
+	defer srv.Close()
+
+	clnt, err := New(srv.Listener.Addr().String(), &Options{Region: "us-east-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		mu     sync.Mutex
+		events []ProgressEvent
+	)
+	listener := ProgressListenerFunc(func(event ProgressEvent) {
+		mu.Lock()
+		events = append(events, event)
+		mu.Unlock()
+	})
+	_, err = clnt.PutObject(context.Background(), "bucket", "object", bytes.NewReader(data), int64(len(data)), PutObjectOptions{
+		PartSize:             absMinPartSize,
+		DisableContentSha256: true,
+		ProgressListener:     listener,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	counts := make(map[ProgressEventType]int)
+	var transferred int64
+	for _, event := range events {
+		counts[event.Type]++
+		switch event.Type {
+		case ProgressBytesTransferred:
+			transferred += event.Bytes
+		case ProgressPartRetried:
+			transferred -= event.Bytes
+			if event.PartNumber != 2 || event.Err == nil {
+				t.Fatalf("Unexpected retry event %+v", event)
+			}
+		}
+	}
+	if first := events[0]; first.Type != ProgressTransferStarted || first.Size != int64(len(data)) {
+		t.Fatalf("Unexpected first event %+v", first)
+	}
+	if last := events[len(events)-1]; last.Type != ProgressTransferCompleted || last.Err != nil {
+		t.Fatalf("Unexpected last event %+v", last)
+	}
+	if counts[ProgressPartStarted] != 3 || counts[ProgressPartCompleted] != 3 || counts[ProgressPartRetried] != 1 {
+		t.Fatalf("Unexpected event counts %v", counts)
+	}
+	if transferred != int64(len(data)) {
+		t.Fatalf("Expected %d bytes transferred, got %d", len(data), transferred)
+	}
+}
@@ -22,9 +22,11 @@ import (
 	"crypto/x509"
 	"errors"
 	"iter"
-	"math"
+	"math/rand/v2"
 	"net/http"
 	"net/url"
+	"strconv"
+	"sync"
 	"time"
 )
 
@@ -45,53 +47,6 @@ var DefaultRetryUnit = 200 * time.Millisecond
 // this maximum time duration.
 var DefaultRetryCap = time.Second
 
-// newRetryTimer creates a timer with exponentially increasing
-// delays until the maximum retry attempts are reached.
-func (c *Client) newRetryTimer(ctx context.Context, maxRetry int, baseSleep, maxSleep time.Duration, jitter float64) iter.Seq[int] {
-	// computes the exponential backoff duration according to
-	// https://www.awsarchitectureblog.com/2015/03/backoff.html
-	exponentialBackoffWait := func(attempt int) time.Duration {
-		// normalize jitter to the range [0, 1.0]
-		if jitter < NoJitter {
-			jitter = NoJitter
-		}
-		if jitter > MaxJitter {
-			jitter = MaxJitter
-		}
-
-		// sleep = random_between(0, min(maxSleep, base * 2 ** attempt))
-		sleep := baseSleep * time.Duration(1<<uint(attempt))
-		if sleep > maxSleep {
-			sleep = maxSleep
-		}
-		if math.Abs(jitter-NoJitter) > 1e-9 {
-			sleep -= time.Duration(c.random.Float64() * float64(sleep) * jitter)
-		}
-		return sleep
-	}
-
-	return func(yield func(int) bool) {
-		// if context is already canceled, skip yield
-		select {
-		case <-ctx.Done():
-			return
-		default:
-		}
-
-		for i := range maxRetry {
-			if !yield(i) {
-				return
-			}
-
-			select {
-			case <-time.After(exponentialBackoffWait(i)):
-			case <-ctx.Done():
-				return
-			}
-		}
-	}
-}
-
 // List of AWS S3 error codes which are retryable.
 var retryableS3Codes = map[string]struct{}{
 	"RequestError":          {},
@@ -154,3 +109,222 @@ func isRequestErrorRetryable(ctx context.Context, err error) bool {
 	}
 	return true
 }
+
+// RetryRequest describes a failed request attempt to a RetryPolicy.
+type RetryRequest struct {
+	// Request of the attempt, nil if it could not be created, e.g.
+	// because credentials could not be retrieved.
+	Request *http.Request
+
+	// Response of the attempt, nil if none was received. The body of
+	// error responses has already been read.
+	Response *http.Response
+
+	// Err is the error of the attempt, an ErrorResponse if the server
+	// returned one.
+	Err error
+
+	// Attempt is zero for the first attempt.
+	Attempt int
+}
+
+// RetryPolicy decides whether and when failed requests are retried.
+// Requests with a body that cannot be rewound are never retried.
+type RetryPolicy interface {
+	// Retry returns the delay before the next attempt, false if the
+	// failed attempt r is not retried.
+	Retry(ctx context.Context, r RetryRequest) (time.Duration, bool)
+}
+
+// DefaultRetryPolicy retries network errors and retryable S3 error
+// codes and HTTP status codes with exponential backoff and full
+// jitter. A Retry-After response header replaces the backoff delay.
+// Zero fields use the package defaults.
+type DefaultRetryPolicy struct {
+	// MaxRetries is the maximum number of attempts, defaults to
+	// MaxRetry. Set to 1 to disable retries.
+	MaxRetries int
+
+	// BaseDelay is the backoff delay before the first retry, doubled
+	// for every following retry up to MaxDelay. Default to
+	// DefaultRetryUnit and DefaultRetryCap.
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+
+	// MaxRetryAfter caps the delay requested by a Retry-After header,
+	// the request is not retried if the server asks for more.
+	// Defaults to one minute.
+	MaxRetryAfter time.Duration
+}
+
+// Default cap of the delay requested by Retry-After.
+const defaultMaxRetryAfter = time.Minute
+
+// Retry implements RetryPolicy.
+func (p DefaultRetryPolicy) Retry(ctx context.Context, r RetryRequest) (time.Duration, bool) {
+	maxRetries := p.MaxRetries
+	if maxRetries <= 0 {
+		maxRetries = MaxRetry
+	}
+	if r.Attempt+1 >= maxRetries {
+		return 0, false
+	}
+
+	switch {
+	case r.Request == nil:
+		if !isS3CodeRetryable(ToErrorResponse(r.Err).Code) {
+			return 0, false
+		}
+	case r.Response == nil:
+		if !isRequestErrorRetryable(ctx, r.Err) {
+			return 0, false
+		}
+	default:
+		if !isS3CodeRetryable(ToErrorResponse(r.Err).Code) && !isHTTPStatusRetryable(r.Response.StatusCode) {
+			return 0, false
+		}
+		if delay, ok := retryAfter(r.Response.Header); ok {
+			maxRetryAfter := p.MaxRetryAfter
+			if maxRetryAfter <= 0 {
+				maxRetryAfter = defaultMaxRetryAfter
+			}
+			return delay, delay <= maxRetryAfter
+		}
+	}
+
+	baseDelay, maxDelay := p.BaseDelay, p.MaxDelay
+	if baseDelay <= 0 {
+		baseDelay = DefaultRetryUnit
+	}
+	if maxDelay <= 0 {
+		maxDelay = DefaultRetryCap
+	}
+	// sleep = random_between(0, min(maxDelay, baseDelay * 2 ** attempt))
+	sleep := maxDelay
+	if r.Attempt < 32 {
+		sleep = min(baseDelay*time.Duration(1<<uint(r.Attempt)), maxDelay)
+	}
+	return sleep - time.Duration(rand.Float64()*float64(sleep)), true
+}
+
+// retryAfter - returns the delay requested by the Retry-After header,
+// either in seconds or as an HTTP date.
+func retryAfter(h http.Header) (time.Duration, bool) {
+	value := h.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		return max(time.Until(t), 0), true
+	}
+	return 0, false
+}
+
+type retryPolicyContextKey struct{}
+
+// WithRetryPolicy returns a context overriding the retry policy of the
+// client for all requests made with it, e.g. to retry the requests of
+// a latency sensitive call fewer times.
+func WithRetryPolicy(ctx context.Context, policy RetryPolicy) context.Context {
+	return context.WithValue(ctx, retryPolicyContextKey{}, policy)
+}
+
+// retryPolicy - returns the retry policy of requests made with ctx.
+func (c *Client) retryPolicy(ctx context.Context) RetryPolicy {
+	if policy, ok := ctx.Value(retryPolicyContextKey{}).(RetryPolicy); ok && policy != nil {
+		return policy
+	}
+	return c.defaultRetryPolicy
+}
+
+// RetryBudget caps the retries of all the requests of a client so that
+// a failing server does not receive several times the usual load.
+// Every retry takes a token from the budget, every successful request
+// returns Ratio tokens. Requests are not retried while the budget is
+// empty.
+type RetryBudget struct {
+	// Tokens is the size of the budget, the number of retries allowed
+	// in a burst. Defaults to 100.
+	Tokens int
+
+	// Ratio of retries allowed per successful request once the budget
+	// is used up. Defaults to 0.1.
+	Ratio float64
+}
+
+// retryBudget - the state of a RetryBudget, all methods are no-ops on a
+// nil retryBudget.
+type retryBudget struct {
+	max   float64
+	ratio float64
+
+	mu     sync.Mutex
+	tokens float64
+}
+
+// newRetryBudget - returns the state of b, nil if b is nil.
+func newRetryBudget(b *RetryBudget) *retryBudget {
+	if b == nil {
+		return nil
+	}
+	rb := &retryBudget{max: float64(b.Tokens), ratio: b.Ratio}
+	if rb.max <= 0 {
+		rb.max = 100
+	}
+	if rb.ratio <= 0 {
+		rb.ratio = 0.1
+	}
+	rb.tokens = rb.max
+	return rb
+}
+
+// take - returns true if a retry is allowed, taking its token.
+func (b *retryBudget) take() bool {
+	if b == nil {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// success - returns tokens for a successful request.
+func (b *retryBudget) success() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	b.tokens = min(b.tokens+b.ratio, b.max)
+	b.mu.Unlock()
+}
+
+// retryAttempts - yields the attempts of a request until the loop body
+// stops, waiting for *delay before every retry.
+func retryAttempts(ctx context.Context, delay *time.Duration) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for attempt := 0; ; attempt++ {
+			// if context is already canceled, skip yield
+			if ctx.Err() != nil {
+				return
+			}
+			if !yield(attempt) {
+				return
+			}
+
+			timer := time.NewTimer(*delay)
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			}
+		}
+	}
+}
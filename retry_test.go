@@ -2,33 +2,23 @@ package minio
 
 import (
 	"context"
+	"errors"
 	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 	"time"
 )
 
-func TestRetryTimer(t *testing.T) {
-	t.Run("withLimit", func(t *testing.T) {
-		t.Parallel()
-		c := &Client{random: rand.New(rand.NewSource(42))}
-		ctx := context.Background()
-		var count int
-		for range c.newRetryTimer(ctx, 3, time.Millisecond, 10*time.Millisecond, 0.0) {
-			count++
-		}
-		if count != 3 {
-			t.Errorf("expected exactly 3 yields")
-		}
-	})
-
+func TestRetryContinuous(t *testing.T) {
 	t.Run("checkDelay", func(t *testing.T) {
 		t.Parallel()
 		c := &Client{random: rand.New(rand.NewSource(42))}
-		ctx := context.Background()
 		prev := time.Now()
 		baseSleep := time.Millisecond
 		maxSleep := 10 * time.Millisecond
-		for i := range c.newRetryTimer(ctx, 6, baseSleep, maxSleep, 0.0) {
+		for i := range c.newRetryTimerContinous(time.Millisecond, 10*time.Millisecond, 0.0) {
 			if i == 0 {
 				// there is no sleep for the first execution
 				if time.Since(prev) >= time.Millisecond {
@@ -43,15 +33,17 @@ func TestRetryTimer(t *testing.T) {
 				t.Errorf("expected to sleep for at least %s", expect.String())
 			}
 			prev = time.Now()
+			if i >= 10 {
+				break
+			}
 		}
 	})
 
 	t.Run("withBreak", func(t *testing.T) {
 		t.Parallel()
 		c := &Client{random: rand.New(rand.NewSource(42))}
-		ctx := context.Background()
 		var count int
-		for range c.newRetryTimer(ctx, 10, time.Millisecond, 10*time.Millisecond, 0.5) {
+		for range c.newRetryTimerContinous(time.Millisecond, 10*time.Millisecond, 0.5) {
 			count++
 			if count >= 3 {
 				break
@@ -61,78 +53,97 @@ func TestRetryTimer(t *testing.T) {
 			t.Errorf("expected exactly 3 yields")
 		}
 	})
+}
 
-	t.Run("withCancelledContext", func(t *testing.T) {
-		t.Parallel()
-		c := &Client{random: rand.New(rand.NewSource(42))}
-		ctx := context.Background()
-		ctx, cancel := context.WithCancel(ctx)
-		cancel()
-		var count int
-		for range c.newRetryTimer(ctx, 10, time.Millisecond, 10*time.Millisecond, 0.5) {
-			count++
-		}
-		if count != 0 {
-			t.Errorf("expected no yields")
+func TestDefaultRetryPolicy(t *testing.T) {
+	ctx := context.Background()
+	req := httptest.NewRequest(http.MethodGet, "/bucket/object", nil)
+	response := func(status int, retryAfter string) *http.Response {
+		res := &http.Response{StatusCode: status, Header: make(http.Header)}
+		if retryAfter != "" {
+			res.Header.Set("Retry-After", retryAfter)
 		}
-	})
-	t.Run("whileCancelledContext", func(t *testing.T) {
-		t.Parallel()
-		c := &Client{random: rand.New(rand.NewSource(42))}
-		ctx := context.Background()
-		ctx, cancel := context.WithCancel(ctx)
-		var count int
-		for range c.newRetryTimer(ctx, 10, time.Millisecond, 10*time.Millisecond, 0.5) {
-			count++
-			cancel()
+		return res
+	}
+
+	p := DefaultRetryPolicy{MaxRetries: 3, BaseDelay: time.Millisecond, MaxDelay: 4 * time.Millisecond, MaxRetryAfter: 10 * time.Second}
+	testCases := []struct {
+		r     RetryRequest
+		retry bool
+		delay time.Duration
+	}{
+		// Retryable status codes back off up to MaxDelay.
+		{RetryRequest{Request: req, Response: response(503, ""), Err: ErrorResponse{Code: "SlowDown"}, Attempt: 1}, true, -1},
+		// Attempts are limited by MaxRetries.
+		{RetryRequest{Request: req, Response: response(503, ""), Err: ErrorResponse{Code: "SlowDown"}, Attempt: 2}, false, 0},
+		{RetryRequest{Request: req, Response: response(404, ""), Err: ErrorResponse{Code: "NoSuchKey"}}, false, 0},
+		// Retry-After in seconds and as a date replaces the backoff.
+		{RetryRequest{Request: req, Response: response(429, "5"), Err: ErrorResponse{Code: "SlowDown"}}, true, 5 * time.Second},
+		{RetryRequest{Request: req, Response: response(503, time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat))}, true, 0},
+		{RetryRequest{Request: req, Response: response(503, "60")}, false, 0},
+		{RetryRequest{Request: req, Err: context.Canceled}, true, -1},
+		{RetryRequest{Err: ErrorResponse{Code: "ExpiredToken"}}, true, -1},
+		{RetryRequest{Err: errors.New("invalid argument")}, false, 0},
+	}
+	for i, tc := range testCases {
+		delay, retry := p.Retry(ctx, tc.r)
+		if retry != tc.retry {
+			t.Fatalf("Test %d: expected retry %v, got %v", i+1, tc.retry, retry)
 		}
-		cancel()
-		if count != 1 {
-			t.Errorf("expected only one yield")
+		if tc.delay < 0 {
+			if maxDelay := min(time.Millisecond<<tc.r.Attempt, p.MaxDelay); delay > maxDelay {
+				t.Fatalf("Test %d: expected delay up to %v, got %v", i+1, maxDelay, delay)
+			}
+		} else if retry && delay != tc.delay {
+			t.Fatalf("Test %d: expected delay %v, got %v", i+1, tc.delay, delay)
 		}
-	})
+	}
 }
 
-func TestRetryContinuous(t *testing.T) {
-	t.Run("checkDelay", func(t *testing.T) {
-		t.Parallel()
-		c := &Client{random: rand.New(rand.NewSource(42))}
-		prev := time.Now()
-		baseSleep := time.Millisecond
-		maxSleep := 10 * time.Millisecond
-		for i := range c.newRetryTimerContinous(time.Millisecond, 10*time.Millisecond, 0.0) {
-			if i == 0 {
-				// there is no sleep for the first execution
-				if time.Since(prev) >= time.Millisecond {
-					t.Errorf("expected to not sleep for the first instance of the loop")
-				}
-				prev = time.Now()
-				continue
-			}
-			expect := baseSleep * time.Duration(1<<uint(i-1))
-			expect = min(expect, maxSleep)
-			if d := time.Since(prev); d < expect || d > 2*maxSleep {
-				t.Errorf("expected to sleep for at least %s", expect.String())
-			}
-			prev = time.Now()
-			if i >= 10 {
-				break
-			}
-		}
-	})
+func TestRetryPolicyOverride(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
 
-	t.Run("withBreak", func(t *testing.T) {
-		t.Parallel()
-		c := &Client{random: rand.New(rand.NewSource(42))}
-		var count int
-		for range c.newRetryTimerContinous(time.Millisecond, 10*time.Millisecond, 0.5) {
-			count++
-			if count >= 3 {
-				break
-			}
-		}
-		if count != 3 {
-			t.Errorf("expected exactly 3 yields")
-		}
+	clnt, err := New(srv.Listener.Addr().String(), &Options{
+		Region:      "us-east-1",
+		RetryPolicy: DefaultRetryPolicy{MaxRetries: 5},
+		RetryBudget: &RetryBudget{Tokens: 5},
 	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Retry-After of zero retries without backoff.
+	start := time.Now()
+	if _, err = clnt.StatObject(context.Background(), "bucket", "object", StatObjectOptions{}); err == nil {
+		t.Fatal("Expected StatObject to fail")
+	}
+	if n := requests.Swap(0); n != 5 {
+		t.Fatalf("Expected 5 attempts, got %d", n)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("Expected no backoff, took %v", elapsed)
+	}
+
+	// Per-call policies override the client policy.
+	ctx := WithRetryPolicy(context.Background(), DefaultRetryPolicy{MaxRetries: 2})
+	if _, err = clnt.StatObject(ctx, "bucket", "object", StatObjectOptions{}); err == nil {
+		t.Fatal("Expected StatObject to fail")
+	}
+	if n := requests.Swap(0); n != 2 {
+		t.Fatalf("Expected 2 attempts, got %d", n)
+	}
+
+	// The retry budget of 5 retries is used up by now.
+	if _, err = clnt.StatObject(context.Background(), "bucket", "object", StatObjectOptions{}); err == nil {
+		t.Fatal("Expected StatObject to fail")
+	}
+	if n := requests.Swap(0); n != 1 {
+		t.Fatalf("Expected a single attempt without budget, got %d", n)
+	}
 }